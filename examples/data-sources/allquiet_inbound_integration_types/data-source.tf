@@ -0,0 +1,17 @@
+data "allquiet_inbound_integration_types" "all" {
+}
+
+data "allquiet_inbound_integration_types" "monitoring" {
+  category = "Monitoring"
+}
+
+check "integration_type_is_valid" {
+  assert {
+    condition     = contains(data.allquiet_inbound_integration_types.all.types[*].id, "Datadog")
+    error_message = "Datadog is not a known inbound integration type."
+  }
+}
+
+output "inbound_integration_type_ids" {
+  value = data.allquiet_inbound_integration_types.all.types[*].id
+}
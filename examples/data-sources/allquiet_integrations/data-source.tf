@@ -0,0 +1,20 @@
+data "allquiet_integrations" "datadog" {
+  type = "Datadog"
+}
+
+# Integrations still muted after muted_until, or muted without muted_until
+# for longer than the provider's mute_warning_threshold_in_days
+data "allquiet_integrations" "expired_mutes" {
+  mute_expired = true
+}
+
+output "datadog_integration_ids" {
+  value = data.allquiet_integrations.datadog.integrations[*].id
+}
+
+output "expired_mutes" {
+  value = {
+    for integration in data.allquiet_integrations.expired_mutes.integrations :
+    integration.display_name => integration.muted_since
+  }
+}
@@ -0,0 +1,17 @@
+data "allquiet_organization_memberships" "owners" {
+  role = "Owner"
+}
+
+data "allquiet_organization_memberships" "all" {
+}
+
+check "at_most_three_owners" {
+  assert {
+    condition     = length(data.allquiet_organization_memberships.owners.organization_memberships) <= 3
+    error_message = "The organization has more than three owners."
+  }
+}
+
+output "owner_user_ids" {
+  value = data.allquiet_organization_memberships.owners.organization_memberships[*].user_id
+}
@@ -0,0 +1,6 @@
+data "allquiet_outbound_integration_types" "all" {
+}
+
+output "outbound_integration_types_by_id" {
+  value = { for t in data.allquiet_outbound_integration_types.all.types : t.id => t.display_name }
+}
@@ -0,0 +1,83 @@
+resource "allquiet_user" "riemann" {
+  display_name = "Bernhard Riemann"
+  email        = "acceptance-tests+riemann@allquiet.app"
+}
+
+resource "allquiet_user" "noether" {
+  display_name = "Emmy Noether"
+  email        = "acceptance-tests+noether@allquiet.app"
+}
+
+data "allquiet_schedule" "eu_follow_the_sun_primary" {
+  display_name = "EU follow-the-sun primary"
+  schedule_settings = {
+    weekly_schedules = [
+      {
+        selected_days = ["mon", "tue", "wed", "thu", "fri"]
+        from          = "07:00"
+        until         = "19:00"
+      }
+    ]
+  }
+  rotation_settings = {
+    repeats               = "weekly"
+    starts_on_day_of_week = "mon"
+  }
+  rotations = [
+    {
+      members = [
+        { user_id = allquiet_user.riemann.id }
+      ]
+    },
+    {
+      members = [
+        { user_id = allquiet_user.noether.id }
+      ]
+    }
+  ]
+}
+
+resource "allquiet_team" "payments" {
+  display_name = "Payments"
+}
+
+resource "allquiet_team" "checkout" {
+  display_name = "Checkout"
+}
+
+resource "allquiet_team_members" "payments" {
+  team_id = allquiet_team.payments.id
+  members = [
+    { user_id = allquiet_user.riemann.id, role = "Administrator" },
+    { user_id = allquiet_user.noether.id, role = "Member" }
+  ]
+}
+
+resource "allquiet_team_members" "checkout" {
+  team_id = allquiet_team.checkout.id
+  members = [
+    { user_id = allquiet_user.riemann.id, role = "Member" },
+    { user_id = allquiet_user.noether.id, role = "Administrator" }
+  ]
+}
+
+# Both teams share the same schedule. Changing it updates both teams.
+resource "allquiet_team_escalations" "payments" {
+  team_id = allquiet_team.payments.id
+  escalation_tiers = [
+    {
+      schedule_refs = [data.allquiet_schedule.eu_follow_the_sun_primary.ref]
+    }
+  ]
+  depends_on = [allquiet_team_members.payments]
+}
+
+resource "allquiet_team_escalations" "checkout" {
+  team_id = allquiet_team.checkout.id
+  escalation_tiers = [
+    {
+      schedule_refs = [data.allquiet_schedule.eu_follow_the_sun_primary.ref]
+    }
+  ]
+  depends_on = [allquiet_team_members.checkout]
+}
@@ -0,0 +1,23 @@
+data "allquiet_team" "platform" {
+  display_name = "(TF Acceptance Test) Team"
+}
+
+data "allquiet_team_escalations" "platform" {
+  team_id = data.allquiet_team.platform.id
+}
+
+locals {
+  # A tier covers 24/7 if one of its schedules has a weekly schedule spanning all days from 00:00 to 00:00
+  platform_has_24_7_tier = anytrue(flatten([
+    for tier in data.allquiet_team_escalations.platform.escalation_tiers : [
+      for schedule in tier.schedules : [
+        for weekly in try(schedule.schedule_settings.weekly_schedules[*], []) :
+        length(weekly.selected_days) == 7 && weekly.from == "00:00" && weekly.until == "00:00"
+      ]
+    ]
+  ]))
+}
+
+output "auto_assign_to_platform" {
+  value = local.platform_has_24_7_tier ? [data.allquiet_team.platform.id] : []
+}
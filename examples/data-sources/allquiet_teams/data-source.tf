@@ -5,6 +5,11 @@ data "allquiet_teams" "teams_by_display_name" {
 data "allquiet_teams" "all_teams" {
 }
 
+data "allquiet_teams" "teams_by_label" {
+  labels             = ["platform"]
+  display_name_regex = "(?i)^platform"
+}
+
 output "team_ids" {
   value = data.allquiet_teams.teams_by_display_name.teams[*].id
 }
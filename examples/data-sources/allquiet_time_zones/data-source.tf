@@ -0,0 +1,14 @@
+data "allquiet_time_zones" "all" {
+}
+
+variable "team_time_zone_id" {
+  type    = string
+  default = "Europe/Zurich"
+}
+
+check "team_time_zone_is_supported" {
+  assert {
+    condition     = contains(data.allquiet_time_zones.all.time_zones[*].id, var.team_time_zone_id)
+    error_message = "${var.team_time_zone_id} is not a time zone supported by All Quiet."
+  }
+}
@@ -17,4 +17,15 @@ data "allquiet_users" "users_by_email" {
 
 data "allquiet_users" "all_users" {
   depends_on = [allquiet_user.test1, allquiet_user.test2]
-}
\ No newline at end of file
+}
+
+data "allquiet_users" "users_by_email_domain" {
+  email_domain       = "allquiet.app"
+  display_name_regex = "^Mil"
+  depends_on         = [allquiet_user.test1, allquiet_user.test2]
+}
+
+data "allquiet_users" "users_by_display_name_glob" {
+  display_name_glob = "Mil* *"
+  depends_on        = [allquiet_user.test1, allquiet_user.test2]
+}
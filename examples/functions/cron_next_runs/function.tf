@@ -0,0 +1,9 @@
+# Preview when a cronjob monitor expects the job to run
+output "nightly_backup_runs" {
+  value = provider::allquiet::cron_next_runs("0 2 * * *", "Europe/Amsterdam", "2025-01-01T00:00:00Z")
+}
+
+# Six fields with leading seconds
+output "every_ten_minutes_runs" {
+  value = provider::allquiet::cron_next_runs("30 */10 * * * *", null, plantimestamp())
+}
@@ -0,0 +1,37 @@
+# Preview the incident attributes a mapping produces for a sample payload
+output "mapped_attributes" {
+  value = provider::allquiet::evaluate_mapping(
+    {
+      attributes = [
+        {
+          name = "Severity"
+          mappings = [
+            { json_path = "$.jsonBody.priority" },
+            { map = "P1->Critical,P2->Warning,->Minor" }
+          ]
+        },
+        {
+          name = "Link"
+          mappings = [
+            { json_path = "$.jsonBody.message" },
+            { regex = "issue (\\d+)", replace = "https://sentry.io/issues/$1/" }
+          ]
+        },
+        {
+          name   = "Labels"
+          expand = true
+          mappings = [
+            { json_path = "$.jsonBody.labels" }
+          ]
+        }
+      ]
+    },
+    jsonencode({
+      jsonBody = {
+        priority = "P1"
+        message  = "New issue 4711 in checkout"
+        labels   = { team = "payments", region = "eu" }
+      }
+    })
+  )
+}
@@ -0,0 +1,24 @@
+resource "allquiet_team" "backups" {
+  display_name = "Backups"
+}
+
+resource "allquiet_integration" "nightly_backup" {
+  display_name = "Nightly Backup"
+  team_id      = allquiet_team.backups.id
+  type         = "CronJobMonitor"
+  integration_settings = {
+    cronjob_monitor = {
+      cron_expression     = "0 2 * * *"
+      grace_period_in_sec = 600
+      severity            = "Critical"
+    }
+  }
+}
+
+# Wrap the backup job, so that All Quiet knows when it starts, succeeds or fails
+output "nightly_backup_script" {
+  value = provider::allquiet::heartbeat_wrapper(
+    allquiet_integration.nightly_backup,
+    "pg_dump --format=custom mydb > /backups/mydb.dump"
+  )
+}
@@ -0,0 +1,18 @@
+# Render a template against the built-in sample incident
+output "sample_payload" {
+  value = provider::allquiet::render_webhook_payload(
+    "{\"summary\": {{ json .title }}, \"severity\": {{ json (lower .severity) }}}",
+    null
+  )
+}
+
+# Render a template against your own incident
+output "custom_payload" {
+  value = provider::allquiet::render_webhook_payload(
+    "{{ .title }} ({{ .severity }})",
+    jsonencode({
+      title    = "Disk full on db-1"
+      severity = "Warning"
+    })
+  )
+}
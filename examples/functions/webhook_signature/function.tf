@@ -0,0 +1,9 @@
+# Signature of a GitHub style sender, sent as X-Hub-Signature-256
+output "github_signature" {
+  value = provider::allquiet::webhook_signature("It's a Secret to Everybody", "Hello, World!", "sha256", null)
+}
+
+# Signature of a sender that also sends a timestamp header
+output "timestamped_signature" {
+  value = provider::allquiet::webhook_signature("whsec_0123456789abcdef", jsonencode({ title = "Disk full" }), null, "1735689600")
+}
@@ -1,4 +1,6 @@
 provider "allquiet" {
   api_key    = "your_api_key" # You can also set this in the ALLQUIET_API_KEY environment variable
   api_region = "us"           # Choose 'us' or 'eu' depending on in which All Quiet data storage region you've setup your All Quiet organization
+
+  mute_warning_threshold_in_days = 14 # Warn in plans about integrations muted for more than 14 days without muted_until
 }
@@ -14,6 +14,13 @@ resource "allquiet_integration" "amazon_cloudwatch" {
   type         = "AmazonCloudWatch"
 }
 
+resource "allquiet_integration" "muted_during_migration" {
+  display_name = "My Muted Integration"
+  team_id      = allquiet_team.root.id
+  type         = "Datadog"
+  muted_until  = "2030-01-31T18:00:00Z" # Unmutes automatically
+}
+
 resource "allquiet_integration" "webhook" {
   display_name = "My Webhook Integration"
   team_id      = allquiet_team.root.id
@@ -27,6 +34,29 @@ resource "allquiet_integration" "webhook" {
       token = "your_secret_token"
     }
   }
+
+  # Change the trigger to rotate a leaked webhook_url, the old url keeps working for an hour
+  webhook_url_rotation_trigger                 = "2025-01-01"
+  webhook_url_rotation_grace_period_in_minutes = 60
+}
+
+resource "allquiet_integration" "webhook_hmac" {
+  display_name = "My Signed Webhook Integration"
+  team_id      = allquiet_team.root.id
+  type         = "Webhook"
+  webhook_authentication = {
+    type = "hmac"
+    # The secret is write-only, bump secret_version to send a new secret
+    hmac = {
+      signature_header               = "X-Hub-Signature-256"
+      algorithm                      = "sha256"
+      secret                         = "your_signing_secret"
+      secret_version                 = 1
+      timestamp_header               = "X-Timestamp"
+      timestamp_tolerance_in_seconds = 300
+    }
+    allowed_source_cidrs = ["192.0.2.0/24", "198.51.100.0/24"]
+  }
 }
 
 resource "allquiet_integration" "webhook_snooze_absolute" {
@@ -193,6 +223,115 @@ resource "allquiet_integration" "ping_monitor_with_max_retries" {
   }
 }
 
+resource "allquiet_integration" "http_transaction_monitor" {
+  display_name = "My Login Flow Monitoring Integration"
+  team_id      = allquiet_team.root.id
+  type         = "HttpTransactionMonitor"
+  integration_settings = {
+    http_transaction_monitor = {
+      timeout_in_milliseconds = 5000
+      interval_in_seconds     = 300
+      severity_degraded       = "Warning"
+      severity_down           = "Critical"
+      steps = [
+        {
+          name   = "login"
+          method = "POST"
+          url    = "https://example.com/api/login"
+          headers = {
+            "Content-Type" = "application/json"
+          }
+          body                  = "{\"username\": \"monitor\", \"password\": \"your_secret_password\"}"
+          expected_status_codes = [200]
+          extractors = [
+            {
+              variable  = "token"
+              json_path = "$.token"
+            }
+          ]
+        },
+        {
+          name   = "dashboard"
+          method = "GET"
+          url    = "https://example.com/api/dashboard"
+          headers = {
+            "Authorization" = "Bearer {{token}}"
+          }
+          assertions = [
+            {
+              source     = "JsonPath"
+              property   = "$.status"
+              comparison = "Equals"
+              value      = "ok"
+            },
+            {
+              source     = "ResponseTimeInMilliseconds"
+              comparison = "LessThan"
+              value      = "2000"
+            }
+          ]
+        }
+      ]
+    }
+  }
+}
+
+resource "allquiet_integration" "tcp_monitor" {
+  display_name = "My TCP Monitoring Integration"
+  team_id      = allquiet_team.root.id
+  type         = "TcpMonitor"
+  integration_settings = {
+    tcp_monitor = {
+      host = "db.example.com"
+      port = 5432
+
+      timeout_in_milliseconds = 1000
+      interval_in_seconds     = 60
+      severity_degraded       = "Warning"
+      severity_down           = "Critical"
+    }
+  }
+}
+
+resource "allquiet_integration" "dns_monitor" {
+  display_name = "My DNS Monitoring Integration"
+  team_id      = allquiet_team.root.id
+  type         = "DnsMonitor"
+  integration_settings = {
+    dns_monitor = {
+      name            = "allquiet.app"
+      record_type     = "MX"
+      expected_values = ["mx1.example.com", "mx2.example.com"]
+      resolver        = "1.1.1.1"
+
+      timeout_in_milliseconds = 1000
+      interval_in_seconds     = 300
+      severity_degraded       = "Warning"
+      severity_down           = "Critical"
+    }
+  }
+}
+
+resource "allquiet_integration" "certificate_monitor" {
+  display_name = "My Certificate Monitoring Integration"
+  team_id      = allquiet_team.root.id
+  type         = "CertificateMonitor"
+  integration_settings = {
+    certificate_monitor = {
+      host = "mail.example.com"
+      port = 993
+      sni  = "imap.example.com"
+
+      days_before_expiry_degraded = 30
+      days_before_expiry_down     = 7
+      timeout_in_milliseconds     = 5000
+      interval_in_seconds         = 3600
+      severity_degraded           = "Warning"
+      severity_down               = "Critical"
+    }
+  }
+}
+
 resource "allquiet_integration" "email_with_aliases" {
   display_name = "My Email Integration"
   team_id      = allquiet_team.root.id
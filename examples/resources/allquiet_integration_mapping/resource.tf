@@ -58,4 +58,56 @@ resource "allquiet_integration_mapping" "datadog_custom_mapping" {
       }
     ]
   }
-}
\ No newline at end of file
+
+  # Evaluated at plan time, nothing is sent to All Quiet
+  test_payloads = [
+    {
+      payload = jsonencode({
+        jsonBody = {
+          title       = "A"
+          project     = { name = "Checkout" }
+          environment = { id = "production" }
+        }
+      })
+      expected_attributes = {
+        Severity    = "Critical"
+        Status      = "Open"
+        Project     = "Checkout"
+        Environment = "production"
+      }
+    }
+  ]
+}
+
+resource "allquiet_integration" "alertmanager" {
+  display_name = "My Alertmanager Integration"
+  team_id      = allquiet_team.root.id
+  type         = "Webhook"
+}
+
+# Expands to the built-in Alertmanager mapping, the plan shows the resulting attributes_mapping
+resource "allquiet_integration_mapping" "alertmanager_preset" {
+  integration_id = allquiet_integration.alertmanager.id
+
+  preset = {
+    name    = "prometheus_alertmanager"
+    version = "v1"
+    attributes = [
+      # Replaces the preset's Severity attribute
+      {
+        name = "Severity"
+        mappings = [
+          { json_path = "$.jsonBody.commonLabels.priority" },
+          { map = "P1->Critical,P2->Warning,->Minor" }
+        ]
+      },
+      # Appended to the preset's attributes
+      {
+        name = "Runbook"
+        mappings = [
+          { json_path = "$.jsonBody.commonAnnotations.runbook_url" }
+        ]
+      }
+    ]
+  }
+}
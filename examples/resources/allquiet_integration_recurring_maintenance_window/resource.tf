@@ -0,0 +1,38 @@
+resource "allquiet_team" "root" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "some_integration" {
+  display_name = "My Datadog Integration"
+  team_id      = allquiet_team.root.id
+  type         = "Datadog"
+}
+
+resource "allquiet_integration_recurring_maintenance_window" "weekly_patching" {
+  integration_id      = allquiet_integration.some_integration.id
+  description         = "Weekly Patch Window"
+  type                = "maintenance"
+  time_zone_id        = "Europe/Zurich"
+  window_horizon_days = 28
+  weekly_schedules = [
+    {
+      selected_days = ["sun"]
+      from          = "02:00"
+      until         = "04:00"
+    }
+  ]
+}
+
+resource "allquiet_integration_recurring_maintenance_window" "nightly_backup" {
+  integration_id = allquiet_integration.some_integration.id
+  description    = "Nightly Backup"
+  type           = "muted"
+  time_zone_id   = "America/New_York"
+  weekly_schedules = [
+    {
+      selected_days = ["mon", "tue", "wed", "thu", "fri"]
+      from          = "23:30"
+      until         = "00:30"
+    }
+  ]
+}
@@ -0,0 +1,29 @@
+resource "allquiet_user" "millie_brown" {
+  display_name = "Millie Bobby Brown"
+  email        = "acceptance-tests+millie@allquiet.app"
+}
+
+resource "allquiet_user" "taylor" {
+  display_name = "Taylor Swift"
+  email        = "acceptance-tests+taylor@allquiet.app"
+}
+
+# Owners are break-glass accounts and must never be removed by Terraform
+data "allquiet_organization_memberships" "owners" {
+  role = "Owner"
+}
+
+resource "allquiet_organization_members" "all" {
+  members = [
+    {
+      user_id = allquiet_user.millie_brown.id
+      role    = "Administrator"
+    },
+    {
+      user_id = allquiet_user.taylor.id
+      role    = "Member"
+    }
+  ]
+  protected_user_ids        = [for membership in data.allquiet_organization_memberships.owners.organization_memberships : membership.user_id]
+  removal_warning_threshold = 5
+}
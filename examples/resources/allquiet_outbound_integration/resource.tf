@@ -133,4 +133,89 @@ resource "allquiet_outbound_integration" "mattermost_severity_channels" {
       selected_channel_ids_critical = ["critical-channel-id"]
     }
   }
-}
\ No newline at end of file
+}
+resource "allquiet_outbound_integration" "ms_teams" {
+  display_name = "My Microsoft Teams Integration"
+  team_id      = allquiet_team.root.id
+  type         = "MicrosoftTeams"
+
+  ms_teams_settings = {
+    selected_team_id = "your-ms-teams-team-id"
+    severity_based_channel_settings = {
+      selected_channel_ids_minor    = ["minor-channel-id"]
+      selected_channel_ids_warning  = ["warning-channel-id"]
+      selected_channel_ids_critical = ["critical-channel-id"]
+    }
+    on_call_reminder_schedule_settings = {
+      run_time     = "09:00"
+      days_of_week = ["mon", "wed", "fri"]
+    }
+    on_call_reminder_channel_ids = ["reminder-channel-id"]
+    tag_on_call_members          = true
+  }
+}
+
+variable "webhook_hmac_secret" {
+  type      = string
+  sensitive = true
+  default   = "change-me"
+}
+
+resource "allquiet_outbound_integration" "webhook_with_settings" {
+  display_name = "My Webhook Integration (With Settings)"
+  team_id      = allquiet_team.root.id
+  type         = "Webhook"
+
+  webhook_settings = {
+    url    = "https://example.com/hooks/allquiet"
+    method = "POST"
+    headers = {
+      "Content-Type" = "application/json"
+      "X-Source"     = "allquiet"
+    }
+    authentication = {
+      type             = "HmacSignature"
+      hmac_secret      = var.webhook_hmac_secret # Write-only, not stored in the state
+      hmac_header_name = "X-Signature"
+      secret_version   = 1 # Increase after changing webhook_hmac_secret
+    }
+    payload_template = <<-EOT
+      {"summary": {{ json .title }}, "severity": {{ json (lower .severity) }}, "link": {{ json .url }}}
+    EOT
+  }
+}
+
+variable "jira_api_token" {
+  type      = string
+  sensitive = true
+  default   = "change-me"
+}
+
+resource "allquiet_outbound_integration" "jira" {
+  display_name = "My Jira Integration"
+  team_id      = allquiet_team.root.id
+  type         = "Jira"
+
+  ticketing_settings = {
+    base_url    = "https://example.atlassian.net"
+    project_key = "OPS"
+    issue_type  = "Incident"
+    priority_mapping = {
+      Critical = "Highest"
+      Warning  = "High"
+      Minor    = "Low"
+    }
+    field_mappings = {
+      "customfield_10010" = "host"
+    }
+    credentials = {
+      username = "ops@example.com"
+      # api_token is write-only, bump secret_version to send a new token
+      api_token      = var.jira_api_token
+      secret_version = 1
+    }
+    create_ticket_intents = ["Created"]
+    comment_intents       = ["Commented", "Escalated"]
+    close_ticket_intents  = ["Resolved"]
+  }
+}
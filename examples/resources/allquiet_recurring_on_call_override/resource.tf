@@ -0,0 +1,24 @@
+resource "allquiet_user" "millie_brown" {
+  display_name = "Millie Bobby Brown"
+  email        = "acceptance-tests+millie@allquiet.app"
+}
+
+resource "allquiet_user" "taylor_swift" {
+  display_name = "Taylor Swift"
+  email        = "acceptance-tests+taylor@allquiet.app"
+}
+
+resource "allquiet_recurring_on_call_override" "millie_brown_fridays_off" {
+  user_id              = allquiet_user.millie_brown.id
+  type                 = "offline"
+  replacement_user_ids = [allquiet_user.taylor_swift.id]
+  time_zone_id         = "Europe/London"
+  until                = "2027-06-30T00:00:00Z"
+  weekly_schedules = [
+    {
+      selected_days = ["fri"]
+      from          = "00:00"
+      until         = "00:00"
+    }
+  ]
+}
@@ -0,0 +1,46 @@
+resource "allquiet_team" "platform" {
+  display_name = "Platform"
+}
+
+resource "allquiet_team" "payments" {
+  display_name = "Payments"
+}
+
+resource "allquiet_routing" "central" {
+  team_id      = allquiet_team.platform.id
+  display_name = "Central Routing"
+}
+
+# Owned by the payments module
+resource "allquiet_routing_rule" "payments_critical" {
+  routing_id   = allquiet_routing.central.id
+  priority     = 10
+  display_name = "Assign critical payment incidents to Payments"
+  conditions = {
+    severities = ["Critical"]
+    attributes = [
+      {
+        name     = "service"
+        operator = "="
+        value    = "payments"
+      }
+    ]
+  }
+  actions = {
+    assign_to_teams   = [allquiet_team.payments.id]
+    rule_flow_control = "Skip"
+  }
+}
+
+# Owned by the platform module
+resource "allquiet_routing_rule" "platform_fallback" {
+  routing_id   = allquiet_routing.central.id
+  priority     = 100
+  display_name = "Assign everything else to Platform"
+  conditions = {
+    statuses = ["Open"]
+  }
+  actions = {
+    assign_to_teams = [allquiet_team.platform.id]
+  }
+}
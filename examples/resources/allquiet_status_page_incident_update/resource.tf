@@ -0,0 +1,31 @@
+resource "allquiet_service" "payment_api" {
+  display_name = "Payment Provider"
+  public_title = "Payment Provider"
+}
+
+resource "allquiet_status_page" "public_status_page" {
+  display_name                     = "Public Status Page"
+  public_title                     = "Public Status Page"
+  history_in_days                  = 30
+  disable_public_subscription      = false
+  public_severity_mapping_critical = "Major Outage"
+  service_groups = [
+    {
+      public_display_name = "External Services"
+      services            = [allquiet_service.payment_api.id]
+    }
+  ]
+}
+
+resource "allquiet_status_page_incident_update" "payment_outage" {
+  status_page_id = allquiet_status_page.public_status_page.id
+  title          = "Payments are failing"
+  message        = "We identified an issue with our payment provider and are working on a fix."
+  status         = "Identified"
+  severity       = "Critical"
+  services       = [allquiet_service.payment_api.id]
+}
+
+output "payment_outage_public_severity" {
+  value = allquiet_status_page_incident_update.payment_outage.public_severity
+}
@@ -0,0 +1,27 @@
+resource "allquiet_service" "payment_api" {
+  display_name = "Payment Provider"
+  public_title = "Payment Provider"
+}
+
+resource "allquiet_status_page" "public_status_page" {
+  display_name                = "Public Status Page"
+  public_title                = "Public Status Page"
+  history_in_days             = 30
+  disable_public_subscription = false
+  service_groups = [
+    {
+      public_display_name = "External Services"
+      services            = [allquiet_service.payment_api.id]
+    }
+  ]
+}
+
+resource "allquiet_status_page_maintenance" "database_upgrade" {
+  status_page_id = allquiet_status_page.public_status_page.id
+  title          = "Database upgrade"
+  message        = "Payments might be delayed by a few minutes while we upgrade our database."
+  services       = [allquiet_service.payment_api.id]
+  start          = "2030-01-15T22:00:00Z"
+  end            = "2030-01-16T02:00:00Z"
+  auto_complete  = true
+}
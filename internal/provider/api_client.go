@@ -4,7 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -34,6 +37,11 @@ type AllQuietAPIClient struct {
 	APIKey      string
 	EndpointURL string
 	HTTPClient  *http.Client
+
+	// MuteWarningThresholdInDays is the number of days after which muted integrations cause a warning.
+	MuteWarningThresholdInDays int64
+
+	locks sync.Map
 }
 
 func NewAllQuietAPIClient(apiKey, endpointURL string, basicAuth *BasicAuth) *AllQuietAPIClient {
@@ -47,6 +55,7 @@ func NewAllQuietAPIClient(apiKey, endpointURL string, basicAuth *BasicAuth) *All
 				BasicAuth: basicAuth,
 			},
 		},
+		MuteWarningThresholdInDays: defaultMuteWarningThresholdInDays,
 	}
 }
 
@@ -114,3 +123,92 @@ func (c *AllQuietAPIClient) delete(ctx context.Context, path string) (*http.Resp
 
 	return c.HTTPClient.Do(req)
 }
+
+// putIfMatch sends a PUT request with the given data as JSON. If etag is not empty, it is sent
+// as If-Match header so the API rejects the request if the resource was changed in the meantime.
+func (c *AllQuietAPIClient) putIfMatch(ctx context.Context, path string, data interface{}, etag string) (*http.Response, error) {
+	tflog.Trace(ctx, "PUT "+path)
+	req, err := c.newRequest("PUT", path, data)
+	if err != nil {
+		return nil, err
+	}
+
+	if etag != "" {
+		req.Header.Set("If-Match", etag)
+	}
+
+	return c.HTTPClient.Do(req)
+}
+
+// isConflictResponse reports whether the API rejected a write because of a concurrent update.
+func isConflictResponse(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusPreconditionFailed
+}
+
+// lock acquires the mutex for the given key and returns the function releasing it. It serializes
+// read-modify-write cycles on API objects that are shared by several Terraform resources.
+func (c *AllQuietAPIClient) lock(key string) func() {
+	value, _ := c.locks.LoadOrStore(key, &sync.Mutex{})
+	mutex := value.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
+}
+
+// pagedResponse holds the continuation token returned by the API's list endpoints.
+// An empty or missing token means the last page was reached.
+type pagedResponse struct {
+	ContinuationToken *string `json:"continuationToken"`
+}
+
+// getAllPages sends GET requests to a list endpoint and follows the continuation token
+// until all pages have been read. decodePage is called with every page body and has to
+// return the continuation token of that page. Returns false if the endpoint responded
+// with 404 on the first page.
+func (c *AllQuietAPIClient) getAllPages(ctx context.Context, path string, decodePage func(body io.Reader) (*string, error)) (bool, error) {
+	var continuationToken *string
+
+	for {
+		pagePath := path
+		if continuationToken != nil {
+			pagePath = AddQueryParam(path, "continuationToken", *continuationToken)
+		}
+
+		nextToken, found, err := c.getPage(ctx, pagePath, continuationToken == nil, decodePage)
+		if err != nil || !found {
+			return found, err
+		}
+
+		if nextToken == nil || *nextToken == "" {
+			return true, nil
+		}
+
+		if continuationToken != nil && *continuationToken == *nextToken {
+			return true, fmt.Errorf("GET %s: API returned the same continuation token twice", path)
+		}
+
+		continuationToken = nextToken
+	}
+}
+
+func (c *AllQuietAPIClient) getPage(ctx context.Context, path string, isFirstPage bool, decodePage func(body io.Reader) (*string, error)) (*string, bool, error) {
+	httpResp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, false, err
+	}
+	defer httpResp.Body.Close()
+
+	if isFirstPage && httpResp.StatusCode == http.StatusNotFound {
+		return nil, false, nil
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, false, logErrorResponse(httpResp, nil)
+	}
+
+	nextToken, err := decodePage(httpResp.Body)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return nextToken, true, nil
+}
@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AllQuietApp/terraform-provider-internal/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &CronNextRunsFunction{}
+
+// cronNextRunsCount is the number of runs listed by next_runs of a cronjob monitor.
+const cronNextRunsCount = 5
+
+func NewCronNextRunsFunction() function.Function {
+	return &CronNextRunsFunction{}
+}
+
+// CronNextRunsFunction lists the next runs of a cron expression.
+type CronNextRunsFunction struct{}
+
+func (f *CronNextRunsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cron_next_runs"
+}
+
+func (f *CronNextRunsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Lists the next runs of a cron expression",
+		MarkdownDescription: "Lists the next five runs of a `cronjob_monitor` cron expression after a point in time, as RFC 3339 timestamps in the given time zone. " +
+			"The expression is parsed like `cron_expression`, with five fields or six fields with leading seconds.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "cron_expression",
+				MarkdownDescription: "The cron expression",
+			},
+			function.StringParameter{
+				Name:                "time_zone_id",
+				MarkdownDescription: "The IANA time zone id the expression is evaluated in. If null, UTC is used.",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "from",
+				MarkdownDescription: "The RFC 3339 timestamp after which runs are listed, e.g. `plantimestamp()`",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *CronNextRunsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+	var timeZoneId *string
+	var from string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &expression, &timeZoneId, &from))
+	if resp.Error != nil {
+		return
+	}
+
+	schedule, err := validators.ParseCronExpression(expression)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid cron expression: %s", err))
+		return
+	}
+
+	location, err := loadCronLocation(timeZoneId)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid time zone id: %s", err))
+		return
+	}
+
+	fromTime, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid timestamp: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, formatCronRuns(schedule.NextRuns(fromTime.In(location), cronNextRunsCount))))
+}
+
+// loadCronLocation returns the location of the time zone id, UTC if it is not set.
+func loadCronLocation(timeZoneId *string) (*time.Location, error) {
+	if timeZoneId == nil {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(*timeZoneId)
+}
+
+func formatCronRuns(runs []time.Time) []string {
+	result := make([]string, len(runs))
+	for i, run := range runs {
+		result[i] = run.Format(time.RFC3339)
+	}
+	return result
+}
+
+// mapCronNextRunsToList returns the next runs of a cronjob monitor from now, or null if the expression
+// cannot be evaluated by the provider.
+func mapCronNextRunsToList(ctx context.Context, expression string, timeZoneId *string) types.List {
+	schedule, err := validators.ParseCronExpression(expression)
+	if err != nil {
+		return types.ListNull(types.StringType)
+	}
+
+	location, err := loadCronLocation(timeZoneId)
+	if err != nil {
+		return types.ListNull(types.StringType)
+	}
+
+	result, diags := types.ListValueFrom(ctx, types.StringType, formatCronRuns(schedule.NextRuns(time.Now().In(location), cronNextRunsCount)))
+	if diags.HasError() {
+		return types.ListNull(types.StringType)
+	}
+
+	return result
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccCronNextRunsFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::cron_next_runs("0 9 * * MON-FRI", "Europe/Amsterdam", "2025-03-28T12:00:00Z")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ListExact([]knownvalue.Check{
+						knownvalue.StringExact("2025-03-31T09:00:00+02:00"),
+						knownvalue.StringExact("2025-04-01T09:00:00+02:00"),
+						knownvalue.StringExact("2025-04-02T09:00:00+02:00"),
+						knownvalue.StringExact("2025-04-03T09:00:00+02:00"),
+						knownvalue.StringExact("2025-04-04T09:00:00+02:00"),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::cron_next_runs("*/0 * * * *", null, "2025-01-01T00:00:00Z")
+}
+`,
+				ExpectError: regexp.MustCompile(`must be a number greater than 0`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::cron_next_runs("0 0 30 2 *", null, "2025-01-01T00:00:00Z")
+}
+`,
+				ExpectError: regexp.MustCompile(`never runs`),
+			},
+		},
+	})
+}
+
+func TestAccCronNextRunsFunctionExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCronNextRunsFunctionExample(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValueAtPath("nightly_backup_runs", tfjsonpath.New(0), knownvalue.StringExact("2025-01-01T02:00:00+01:00")),
+					statecheck.ExpectKnownOutputValue("every_ten_minutes_runs", knownvalue.ListSizeExact(5)),
+				},
+			},
+		},
+	})
+}
+
+func testAccCronNextRunsFunctionExample() string {
+	absPath, _ := filepath.Abs("../../examples/functions/cron_next_runs/function.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(dat)
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &EvaluateMappingFunction{}
+
+func NewEvaluateMappingFunction() function.Function {
+	return &EvaluateMappingFunction{}
+}
+
+// EvaluateMappingFunction evaluates an integration mapping against a payload without sending it to All Quiet.
+type EvaluateMappingFunction struct{}
+
+func (f *EvaluateMappingFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "evaluate_mapping"
+}
+
+func (f *EvaluateMappingFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluates an integration mapping against a payload",
+		MarkdownDescription: "Evaluates the `attributes_mapping` of an `allquiet_integration_mapping` against a payload and returns the resulting incident attributes, " +
+			"so mappings can be tested before a real alert arrives. Attributes that evaluate to an empty value are left out.\n\n" +
+			"JSONPath and XPath are evaluated in the subset commonly used in mappings (child, recursive descent, wildcard and index selectors, as well as XPath attribute and `text()` steps " +
+			"with index and equality predicates). Regular expressions are evaluated with Go's syntax, which covers the .NET flavor except for lookarounds and backreferences.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "mapping",
+				MarkdownDescription: "The attributes mapping, e.g. `allquiet_integration_mapping.example.attributes_mapping` or an object of the same structure",
+			},
+			function.StringParameter{
+				Name:                "payload",
+				MarkdownDescription: "The payload the mapping is evaluated against, e.g. from `jsonencode`",
+			},
+		},
+		Return: function.MapReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *EvaluateMappingFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var mapping types.Dynamic
+	var payload string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &mapping, &payload))
+	if resp.Error != nil {
+		return
+	}
+
+	attributes, err := mapDynamicToMappingAttributes(mapping)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid mapping: %s", err))
+		return
+	}
+
+	result, err := evaluateIntegrationMapping(attributes, payload)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to evaluate mapping: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// mapDynamicToMappingAttributes decodes a mapping of any object type by round-tripping it through JSON,
+// so that optional attributes can be omitted.
+func mapDynamicToMappingAttributes(mapping types.Dynamic) ([]mappingAttribute, error) {
+	value, err := attrValueToJSONValue(mapping)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition struct {
+		Attributes []mappingAttribute `json:"attributes"`
+	}
+	err = json.Unmarshal(data, &definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return definition.Attributes, nil
+}
+
+func attrValueToJSONValue(value attr.Value) (any, error) {
+	if value.IsUnknown() {
+		return nil, fmt.Errorf("value is not known yet")
+	}
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	switch typed := value.(type) {
+	case basetypes.DynamicValue:
+		return attrValueToJSONValue(typed.UnderlyingValue())
+	case basetypes.StringValue:
+		return typed.ValueString(), nil
+	case basetypes.BoolValue:
+		return typed.ValueBool(), nil
+	case basetypes.NumberValue:
+		return json.Number(typed.ValueBigFloat().Text('f', -1)), nil
+	case basetypes.Int64Value:
+		return typed.ValueInt64(), nil
+	case basetypes.Float64Value:
+		return json.Number(big.NewFloat(typed.ValueFloat64()).Text('f', -1)), nil
+	case basetypes.ObjectValue:
+		return attrValuesToJSONObject(typed.Attributes())
+	case basetypes.MapValue:
+		return attrValuesToJSONObject(typed.Elements())
+	case basetypes.ListValue:
+		return attrValuesToJSONArray(typed.Elements())
+	case basetypes.SetValue:
+		return attrValuesToJSONArray(typed.Elements())
+	case basetypes.TupleValue:
+		return attrValuesToJSONArray(typed.Elements())
+	default:
+		return nil, fmt.Errorf("unsupported value %s", value.String())
+	}
+}
+
+func attrValuesToJSONObject(values map[string]attr.Value) (map[string]any, error) {
+	result := make(map[string]any, len(values))
+	for key, element := range values {
+		converted, err := attrValueToJSONValue(element)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = converted
+	}
+
+	return result, nil
+}
+
+func attrValuesToJSONArray(values []attr.Value) ([]any, error) {
+	result := make([]any, len(values))
+	for i, element := range values {
+		converted, err := attrValueToJSONValue(element)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = converted
+	}
+
+	return result, nil
+}
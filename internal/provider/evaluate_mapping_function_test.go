@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestAccEvaluateMappingFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::evaluate_mapping(
+    {
+      attributes = [
+        {
+          name     = "Title"
+          mappings = [{ xpath = "//alert/title" }]
+        },
+        {
+          name     = "Level"
+          mappings = [{ xpath = "/alert/severity/@level" }, { map = "high->Critical,->Minor" }]
+        },
+        {
+          name     = "Status"
+          mappings = [{ static = "Open" }]
+        }
+      ]
+    },
+    "<alert><title>Disk full</title><severity level=\"high\" /></alert>"
+  )
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.MapExact(map[string]knownvalue.Check{
+						"Title":  knownvalue.StringExact("Disk full"),
+						"Level":  knownvalue.StringExact("Critical"),
+						"Status": knownvalue.StringExact("Open"),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::evaluate_mapping(
+    { attributes = [{ name = "Title", mappings = [{ json_path = "$.title[?(@.x)]" }] }] },
+    "{}"
+  )
+}
+`,
+				ExpectError: regexp.MustCompile(`filters and scripts are not supported`),
+			},
+		},
+	})
+}
+
+func TestAccEvaluateMappingFunctionExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluateMappingFunctionExample(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("mapped_attributes", knownvalue.MapExact(map[string]knownvalue.Check{
+						"Severity":      knownvalue.StringExact("Critical"),
+						"Link":          knownvalue.StringExact("https://sentry.io/issues/4711/"),
+						"Labels.team":   knownvalue.StringExact("payments"),
+						"Labels.region": knownvalue.StringExact("eu"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccEvaluateMappingFunctionExample() string {
+	absPath, _ := filepath.Abs("../../examples/functions/evaluate_mapping/function.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(dat)
+}
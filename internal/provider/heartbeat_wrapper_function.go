@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &HeartbeatWrapperFunction{}
+
+func NewHeartbeatWrapperFunction() function.Function {
+	return &HeartbeatWrapperFunction{}
+}
+
+// HeartbeatWrapperFunction generates a shell script that runs a command and pings a heartbeat or cronjob monitor.
+type HeartbeatWrapperFunction struct{}
+
+// heartbeatPingUrls are the ping urls of a heartbeat_monitor or cronjob_monitor.
+type heartbeatPingUrls struct {
+	PingUrl      *string `json:"ping_url"`
+	PingUrlStart *string `json:"ping_url_start"`
+	PingUrlFail  *string `json:"ping_url_fail"`
+}
+
+const heartbeatPingCommand = "curl -fsS -m 10 --retry 3 -o /dev/null"
+
+func (f *HeartbeatWrapperFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "heartbeat_wrapper"
+}
+
+func (f *HeartbeatWrapperFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Generates a shell wrapper that pings a heartbeat or cronjob monitor",
+		MarkdownDescription: "Generates a POSIX shell script that pings `ping_url_start`, runs the command and then pings `ping_url` if the command succeeded " +
+			"or `ping_url_fail` if it failed. The script exits with the exit code of the command. Pings use `curl` and never fail the script.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name:                "integration",
+				MarkdownDescription: "An `allquiet_integration` with a `heartbeat_monitor` or `cronjob_monitor`, or one of these blocks itself",
+			},
+			function.StringParameter{
+				Name:                "command",
+				MarkdownDescription: "The shell command of the job",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *HeartbeatWrapperFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var integration types.Dynamic
+	var command string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &integration, &command))
+	if resp.Error != nil {
+		return
+	}
+
+	urls, err := mapDynamicToHeartbeatPingUrls(integration)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid integration: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, renderHeartbeatWrapper(urls, command)))
+}
+
+// mapDynamicToHeartbeatPingUrls returns the ping urls of an integration or of a monitor block.
+func mapDynamicToHeartbeatPingUrls(integration types.Dynamic) (*heartbeatPingUrls, error) {
+	value, err := attrValueToJSONValue(integration)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	var definition struct {
+		heartbeatPingUrls
+		IntegrationSettings *struct {
+			HeartbeatMonitor *heartbeatPingUrls `json:"heartbeat_monitor"`
+			CronjobMonitor   *heartbeatPingUrls `json:"cronjob_monitor"`
+		} `json:"integration_settings"`
+	}
+	err = json.Unmarshal(data, &definition)
+	if err != nil {
+		return nil, err
+	}
+
+	urls := &definition.heartbeatPingUrls
+	if settings := definition.IntegrationSettings; settings != nil {
+		if settings.HeartbeatMonitor != nil {
+			urls = settings.HeartbeatMonitor
+		} else if settings.CronjobMonitor != nil {
+			urls = settings.CronjobMonitor
+		}
+	}
+
+	if urls.PingUrl == nil || *urls.PingUrl == "" {
+		return nil, fmt.Errorf("no ping_url found, expected an integration with a heartbeat_monitor or cronjob_monitor")
+	}
+
+	return urls, nil
+}
+
+func renderHeartbeatWrapper(urls *heartbeatPingUrls, command string) string {
+	var script strings.Builder
+
+	script.WriteString("#!/bin/sh\n")
+	if urls.PingUrlStart != nil && *urls.PingUrlStart != "" {
+		fmt.Fprintf(&script, "%s %s || true\n", heartbeatPingCommand, shellQuote(*urls.PingUrlStart))
+	}
+
+	fmt.Fprintf(&script, "(\n%s\n)\n", strings.TrimRight(command, "\n"))
+	script.WriteString("status=$?\n")
+	script.WriteString("if [ \"$status\" -eq 0 ]; then\n")
+	fmt.Fprintf(&script, "  %s %s || true\n", heartbeatPingCommand, shellQuote(*urls.PingUrl))
+	if urls.PingUrlFail != nil && *urls.PingUrlFail != "" {
+		script.WriteString("else\n")
+		fmt.Fprintf(&script, "  %s %s || true\n", heartbeatPingCommand, shellQuote(*urls.PingUrlFail))
+	}
+	script.WriteString("fi\n")
+	script.WriteString("exit \"$status\"\n")
+
+	return script.String()
+}
+
+// shellQuote quotes the value for POSIX shells.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccHeartbeatWrapperFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::heartbeat_wrapper(
+    {
+      ping_url       = "https://allquiet.app/ping/abc"
+      ping_url_start = "https://allquiet.app/ping/abc/start"
+      ping_url_fail  = "https://allquiet.app/ping/abc/fail"
+    },
+    "echo 'done'"
+  )
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `#!/bin/sh
+curl -fsS -m 10 --retry 3 -o /dev/null 'https://allquiet.app/ping/abc/start' || true
+(
+echo 'done'
+)
+status=$?
+if [ "$status" -eq 0 ]; then
+  curl -fsS -m 10 --retry 3 -o /dev/null 'https://allquiet.app/ping/abc' || true
+else
+  curl -fsS -m 10 --retry 3 -o /dev/null 'https://allquiet.app/ping/abc/fail' || true
+fi
+exit "$status"
+`),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::heartbeat_wrapper({ integration_settings = { ping_monitor = {} } }, "true")
+}
+`,
+				ExpectError: regexp.MustCompile(`no ping_url found`),
+			},
+		},
+	})
+}
+
+func TestAccHeartbeatWrapperFunctionExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHeartbeatWrapperFunctionExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("allquiet_integration.nightly_backup", "integration_settings.cronjob_monitor.ping_url"),
+					resource.TestMatchOutput("nightly_backup_script", regexp.MustCompile(`pg_dump --format=custom mydb`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccHeartbeatWrapperFunctionExample() string {
+	absPath, _ := filepath.Abs("../../examples/functions/heartbeat_wrapper/function.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return RandomizeExample(string(dat))
+}
@@ -11,17 +11,24 @@ import (
 )
 
 type integrationResponse struct {
-	Id                    string                         `json:"id"`
-	DisplayName           string                         `json:"displayName"`
-	TeamId                string                         `json:"teamId"`
-	Labels                *[]string                      `json:"labels"`
-	IsMuted               bool                           `json:"isMuted"`
-	IsInMaintenance       bool                           `json:"isInMaintenance"`
-	Type                  string                         `json:"type"`
-	WebhookUrl            *string                        `json:"webhookUrl"`
-	SnoozeSettings        *snoozeSettingsResponse        `json:"snoozeSettings"`
-	WebhookAuthentication *webhookAuthenticationResponse `json:"webhookAuthentication"`
-	IntegrationSettings   *integrationSettingsResponse   `json:"integrationSettings"`
+	Id                           string                         `json:"id"`
+	DisplayName                  string                         `json:"displayName"`
+	TeamId                       string                         `json:"teamId"`
+	Labels                       *[]string                      `json:"labels"`
+	IsMuted                      bool                           `json:"isMuted"`
+	MutedUntil                   *string                        `json:"mutedUntil"`
+	MutedSince                   *string                        `json:"mutedSince"`
+	IsInMaintenance              bool                           `json:"isInMaintenance"`
+	Type                         string                         `json:"type"`
+	WebhookUrl                   *string                        `json:"webhookUrl"`
+	PreviousWebhookUrlValidUntil *string                        `json:"previousWebhookUrlValidUntil"`
+	SnoozeSettings               *snoozeSettingsResponse        `json:"snoozeSettings"`
+	WebhookAuthentication        *webhookAuthenticationResponse `json:"webhookAuthentication"`
+	IntegrationSettings          *integrationSettingsResponse   `json:"integrationSettings"`
+}
+
+type integrationWebhookUrlRotationRequest struct {
+	GracePeriodInMinutes *int64 `json:"gracePeriodInMinutes"`
 }
 
 type integrationCreateRequest struct {
@@ -29,6 +36,7 @@ type integrationCreateRequest struct {
 	TeamId                string                         `json:"teamId"`
 	Labels                *[]string                      `json:"labels,omitempty"`
 	IsMuted               bool                           `json:"isMuted"`
+	MutedUntil            *string                        `json:"mutedUntil"`
 	IsInMaintenance       bool                           `json:"isInMaintenance"`
 	Type                  string                         `json:"type"`
 	SnoozeSettings        *snoozeSettingsResponse        `json:"snoozeSettings"`
@@ -37,20 +45,35 @@ type integrationCreateRequest struct {
 }
 
 type webhookAuthenticationResponse struct {
-	Type   string                               `json:"type"`
-	Bearer *webhookAuthenticationBearerResponse `json:"bearer"`
+	Type               *string                              `json:"type"`
+	Bearer             *webhookAuthenticationBearerResponse `json:"bearer"`
+	Hmac               *webhookAuthenticationHmacResponse   `json:"hmac"`
+	AllowedSourceCidrs *[]string                            `json:"allowedSourceCidrs"`
 }
 
 type webhookAuthenticationBearerResponse struct {
 	Token string `json:"token"`
 }
 
+// webhookAuthenticationHmacResponse is sent with its secret, which the API never returns.
+type webhookAuthenticationHmacResponse struct {
+	SignatureHeader             string  `json:"signatureHeader"`
+	Algorithm                   string  `json:"algorithm"`
+	Secret                      *string `json:"secret,omitempty"`
+	TimestampHeader             *string `json:"timestampHeader"`
+	TimestampToleranceInSeconds *int64  `json:"timestampToleranceInSeconds"`
+}
+
 type integrationSettingsResponse struct {
-	HttpMonitoring   *httpMonitoringResponse   `json:"httpMonitoring"`
-	HeartbeatMonitor *heartbeatMonitorResponse `json:"heartbeatMonitor"`
-	CronjobMonitor   *cronjobMonitorResponse   `json:"cronjobMonitor"`
-	PingMonitor      *pingMonitorResponse      `json:"pingMonitor"`
-	Email            *emailResponse            `json:"email"`
+	HttpMonitoring         *httpMonitoringResponse         `json:"httpMonitoring"`
+	HttpTransactionMonitor *httpTransactionMonitorResponse `json:"httpTransactionMonitor"`
+	HeartbeatMonitor       *heartbeatMonitorResponse       `json:"heartbeatMonitor"`
+	CronjobMonitor         *cronjobMonitorResponse         `json:"cronjobMonitor"`
+	PingMonitor            *pingMonitorResponse            `json:"pingMonitor"`
+	TcpMonitor             *tcpMonitorResponse             `json:"tcpMonitor"`
+	DnsMonitor             *dnsMonitorResponse             `json:"dnsMonitor"`
+	CertificateMonitor     *certificateMonitorResponse     `json:"certificateMonitor"`
+	Email                  *emailResponse                  `json:"email"`
 }
 
 type emailResponse struct {
@@ -68,10 +91,51 @@ type pingMonitorResponse struct {
 	IsPaused              bool   `json:"isPaused"`
 }
 
+type tcpMonitorResponse struct {
+	Host                  string  `json:"host"`
+	Port                  int64   `json:"port"`
+	TimeoutInMilliseconds int64   `json:"timeoutInMilliseconds"`
+	MaxRetries            int64   `json:"maxRetries"`
+	IntervalInSeconds     int64   `json:"intervalInSeconds"`
+	SeverityDegraded      *string `json:"severityDegraded"`
+	SeverityDown          *string `json:"severityDown"`
+	IsPaused              bool    `json:"isPaused"`
+}
+
+type dnsMonitorResponse struct {
+	Name                  string    `json:"name"`
+	RecordType            string    `json:"recordType"`
+	ExpectedValues        *[]string `json:"expectedValues"`
+	Resolver              *string   `json:"resolver"`
+	TimeoutInMilliseconds int64     `json:"timeoutInMilliseconds"`
+	MaxRetries            int64     `json:"maxRetries"`
+	IntervalInSeconds     int64     `json:"intervalInSeconds"`
+	SeverityDegraded      *string   `json:"severityDegraded"`
+	SeverityDown          *string   `json:"severityDown"`
+	IsPaused              bool      `json:"isPaused"`
+}
+
+type certificateMonitorResponse struct {
+	Host                     string  `json:"host"`
+	Port                     int64   `json:"port"`
+	Sni                      *string `json:"sni"`
+	DaysBeforeExpiryDegraded *int64  `json:"daysBeforeExpiryDegraded"`
+	DaysBeforeExpiryDown     *int64  `json:"daysBeforeExpiryDown"`
+	TimeoutInMilliseconds    int64   `json:"timeoutInMilliseconds"`
+	MaxRetries               int64   `json:"maxRetries"`
+	IntervalInSeconds        int64   `json:"intervalInSeconds"`
+	SeverityDegraded         *string `json:"severityDegraded"`
+	SeverityDown             *string `json:"severityDown"`
+	IsPaused                 bool    `json:"isPaused"`
+}
+
 type heartbeatMonitorResponse struct {
-	IntervalInSec    int64  `json:"intervalInSec"`
-	GracePeriodInSec int64  `json:"gracePeriodInSec"`
-	Severity         string `json:"severity"`
+	IntervalInSec    int64   `json:"intervalInSec"`
+	GracePeriodInSec int64   `json:"gracePeriodInSec"`
+	Severity         string  `json:"severity"`
+	PingUrl          *string `json:"pingUrl,omitempty"`
+	PingUrlStart     *string `json:"pingUrlStart,omitempty"`
+	PingUrlFail      *string `json:"pingUrlFail,omitempty"`
 }
 
 type cronjobMonitorResponse struct {
@@ -79,6 +143,9 @@ type cronjobMonitorResponse struct {
 	GracePeriodInSec int64   `json:"gracePeriodInSec"`
 	Severity         string  `json:"severity"`
 	TimeZoneId       *string `json:"timeZoneId"`
+	PingUrl          *string `json:"pingUrl,omitempty"`
+	PingUrlStart     *string `json:"pingUrlStart,omitempty"`
+	PingUrlFail      *string `json:"pingUrlFail,omitempty"`
 }
 
 type httpMonitoringResponse struct {
@@ -103,6 +170,46 @@ type httpMonitoringResponse struct {
 	IgnoreNonHttpErrors                bool               `json:"ignoreNonHttpErrors"`
 }
 
+type httpTransactionMonitorResponse struct {
+	TimeoutInMilliseconds       int64                         `json:"timeoutInMilliseconds"`
+	MaxRetries                  int64                         `json:"maxRetries"`
+	IntervalInSeconds           int64                         `json:"intervalInSeconds"`
+	AuthenticationType          *string                       `json:"authenticationType"`
+	BasicAuthenticationUsername *string                       `json:"basicAuthenticationUsername"`
+	BasicAuthenticationPassword *string                       `json:"basicAuthenticationPassword"`
+	BearerAuthenticationToken   *string                       `json:"bearerAuthenticationToken"`
+	SeverityDegraded            *string                       `json:"severityDegraded"`
+	SeverityDown                *string                       `json:"severityDown"`
+	IsPaused                    bool                          `json:"isPaused"`
+	IgnoreNonHttpErrors         bool                          `json:"ignoreNonHttpErrors"`
+	Steps                       []httpTransactionStepResponse `json:"steps"`
+}
+
+type httpTransactionStepResponse struct {
+	Name                string                              `json:"name"`
+	Method              string                              `json:"method"`
+	Url                 string                              `json:"url"`
+	Headers             *map[string]string                  `json:"headers"`
+	Body                *string                             `json:"body"`
+	ExpectedStatusCodes *[]int                              `json:"expectedStatusCodes"`
+	Extractors          *[]httpTransactionExtractorResponse `json:"extractors"`
+	Assertions          *[]httpTransactionAssertionResponse `json:"assertions"`
+}
+
+type httpTransactionExtractorResponse struct {
+	Variable string  `json:"variable"`
+	JSONPath *string `json:"jsonPath"`
+	Regex    *string `json:"regex"`
+	Header   *string `json:"header"`
+}
+
+type httpTransactionAssertionResponse struct {
+	Source     string  `json:"source"`
+	Property   *string `json:"property"`
+	Comparison string  `json:"comparison"`
+	Value      string  `json:"value"`
+}
+
 type snoozeSettingsResponse struct {
 	SnoozeWindowInMinutes *int64                  `json:"snoozeWindowInMinutes"`
 	Filters               *[]snoozeFilterResponse `json:"filters"`
@@ -123,6 +230,7 @@ func mapIntegrationCreateRequest(plan *IntegrationModel) *integrationCreateReque
 		TeamId:                plan.TeamId.ValueString(),
 		Labels:                ListToStringArray(plan.Labels),
 		IsMuted:               plan.IsMuted.ValueBool(),
+		MutedUntil:            plan.MutedUntil.ValueStringPointer(),
 		IsInMaintenance:       plan.IsInMaintenance.ValueBool(),
 		Type:                  plan.Type.ValueString(),
 		SnoozeSettings:        mapSnoozeSettingsCreateRequest(plan.SnoozeSettings),
@@ -137,11 +245,15 @@ func mapIntegrationSettingsCreateRequest(plan *IntegrationSettingsModel) *integr
 	}
 
 	return &integrationSettingsResponse{
-		HttpMonitoring:   mapHttpMonitoringCreateRequest(plan.HttpMonitoring),
-		HeartbeatMonitor: mapHeartbeatMonitorCreateRequest(plan.HeartbeatMonitor),
-		CronjobMonitor:   mapCronjobMonitorCreateRequest(plan.CronjobMonitor),
-		PingMonitor:      mapPingMonitorCreateRequest(plan.PingMonitor),
-		Email:            mapEmailCreateRequest(plan.Email),
+		HttpMonitoring:         mapHttpMonitoringCreateRequest(plan.HttpMonitoring),
+		HttpTransactionMonitor: mapHttpTransactionMonitorCreateRequest(plan.HttpTransactionMonitor),
+		HeartbeatMonitor:       mapHeartbeatMonitorCreateRequest(plan.HeartbeatMonitor),
+		CronjobMonitor:         mapCronjobMonitorCreateRequest(plan.CronjobMonitor),
+		PingMonitor:            mapPingMonitorCreateRequest(plan.PingMonitor),
+		TcpMonitor:             mapTcpMonitorCreateRequest(plan.TcpMonitor),
+		DnsMonitor:             mapDnsMonitorCreateRequest(plan.DnsMonitor),
+		CertificateMonitor:     mapCertificateMonitorCreateRequest(plan.CertificateMonitor),
+		Email:                  mapEmailCreateRequest(plan.Email),
 	}
 }
 
@@ -171,6 +283,62 @@ func mapPingMonitorCreateRequest(plan *PingMonitorModel) *pingMonitorResponse {
 	}
 }
 
+func mapTcpMonitorCreateRequest(plan *TcpMonitorModel) *tcpMonitorResponse {
+	if plan == nil {
+		return nil
+	}
+
+	return &tcpMonitorResponse{
+		Host:                  plan.Host.ValueString(),
+		Port:                  plan.Port.ValueInt64(),
+		TimeoutInMilliseconds: plan.TimeoutInMilliseconds.ValueInt64(),
+		IntervalInSeconds:     plan.IntervalInSeconds.ValueInt64(),
+		MaxRetries:            plan.MaxRetries.ValueInt64(),
+		SeverityDegraded:      plan.SeverityDegraded.ValueStringPointer(),
+		SeverityDown:          plan.SeverityDown.ValueStringPointer(),
+		IsPaused:              plan.IsPaused.ValueBool(),
+	}
+}
+
+func mapDnsMonitorCreateRequest(plan *DnsMonitorModel) *dnsMonitorResponse {
+	if plan == nil {
+		return nil
+	}
+
+	return &dnsMonitorResponse{
+		Name:                  plan.Name.ValueString(),
+		RecordType:            plan.RecordType.ValueString(),
+		ExpectedValues:        ListToStringArray(plan.ExpectedValues),
+		Resolver:              plan.Resolver.ValueStringPointer(),
+		TimeoutInMilliseconds: plan.TimeoutInMilliseconds.ValueInt64(),
+		IntervalInSeconds:     plan.IntervalInSeconds.ValueInt64(),
+		MaxRetries:            plan.MaxRetries.ValueInt64(),
+		SeverityDegraded:      plan.SeverityDegraded.ValueStringPointer(),
+		SeverityDown:          plan.SeverityDown.ValueStringPointer(),
+		IsPaused:              plan.IsPaused.ValueBool(),
+	}
+}
+
+func mapCertificateMonitorCreateRequest(plan *CertificateMonitorModel) *certificateMonitorResponse {
+	if plan == nil {
+		return nil
+	}
+
+	return &certificateMonitorResponse{
+		Host:                     plan.Host.ValueString(),
+		Port:                     plan.Port.ValueInt64(),
+		Sni:                      plan.Sni.ValueStringPointer(),
+		DaysBeforeExpiryDegraded: plan.DaysBeforeExpiryDegraded.ValueInt64Pointer(),
+		DaysBeforeExpiryDown:     plan.DaysBeforeExpiryDown.ValueInt64Pointer(),
+		TimeoutInMilliseconds:    plan.TimeoutInMilliseconds.ValueInt64(),
+		IntervalInSeconds:        plan.IntervalInSeconds.ValueInt64(),
+		MaxRetries:               plan.MaxRetries.ValueInt64(),
+		SeverityDegraded:         plan.SeverityDegraded.ValueStringPointer(),
+		SeverityDown:             plan.SeverityDown.ValueStringPointer(),
+		IsPaused:                 plan.IsPaused.ValueBool(),
+	}
+}
+
 func mapHeartbeatMonitorCreateRequest(plan *HeartbeatMonitorModel) *heartbeatMonitorResponse {
 
 	if plan == nil {
@@ -230,7 +398,7 @@ func mapHttpMonitoringCreateRequest(plan *HttpMonitoringModel) *httpMonitoringRe
 		BasicAuthenticationUsername:        plan.BasicAuthenticationUsername.ValueStringPointer(),
 		BasicAuthenticationPassword:        plan.BasicAuthenticationPassword.ValueStringPointer(),
 		BearerAuthenticationToken:          plan.BearerAuthenticationToken.ValueStringPointer(),
-		Headers:                            mapHeadersCreateRequest(plan.Headers),
+		Headers:                            MapToStringMap(plan.Headers),
 		Body:                               plan.Body.ValueStringPointer(),
 		IsPaused:                           plan.IsPaused.ValueBool(),
 		ContentTest:                        plan.ContentTest.ValueStringPointer(),
@@ -243,24 +411,76 @@ func mapHttpMonitoringCreateRequest(plan *HttpMonitoringModel) *httpMonitoringRe
 	}
 }
 
-func mapHeadersCreateRequest(plan types.Map) *map[string]string {
-	if plan.IsNull() || plan.IsUnknown() {
+func mapHttpTransactionMonitorCreateRequest(plan *HttpTransactionMonitorModel) *httpTransactionMonitorResponse {
+	if plan == nil {
 		return nil
 	}
 
-	headers := make(map[string]string)
-	for k, v := range plan.Elements() {
-		if v.IsNull() || v.IsUnknown() {
-			continue
+	result := &httpTransactionMonitorResponse{
+		TimeoutInMilliseconds:       plan.TimeoutInMilliseconds.ValueInt64(),
+		MaxRetries:                  plan.MaxRetries.ValueInt64(),
+		IntervalInSeconds:           plan.IntervalInSeconds.ValueInt64(),
+		AuthenticationType:          plan.AuthenticationType.ValueStringPointer(),
+		BasicAuthenticationUsername: plan.BasicAuthenticationUsername.ValueStringPointer(),
+		BasicAuthenticationPassword: plan.BasicAuthenticationPassword.ValueStringPointer(),
+		BearerAuthenticationToken:   plan.BearerAuthenticationToken.ValueStringPointer(),
+		SeverityDegraded:            plan.SeverityDegraded.ValueStringPointer(),
+		SeverityDown:                plan.SeverityDown.ValueStringPointer(),
+		IsPaused:                    plan.IsPaused.ValueBool(),
+		IgnoreNonHttpErrors:         plan.IgnoreNonHttpErrors.ValueBool(),
+		Steps:                       make([]httpTransactionStepResponse, len(plan.Steps)),
+	}
+
+	for i, step := range plan.Steps {
+		result.Steps[i] = httpTransactionStepResponse{
+			Name:                step.Name.ValueString(),
+			Method:              step.Method.ValueString(),
+			Url:                 step.Url.ValueString(),
+			Headers:             MapToStringMap(step.Headers),
+			Body:                step.Body.ValueStringPointer(),
+			ExpectedStatusCodes: listInt64ToIntSlice(step.ExpectedStatusCodes),
+			Extractors:          mapHttpTransactionExtractorsCreateRequest(step.Extractors),
+			Assertions:          mapHttpTransactionAssertionsCreateRequest(step.Assertions),
 		}
-		str, ok := v.(types.String)
-		if !ok {
-			continue
+	}
+
+	return result
+}
+
+func mapHttpTransactionExtractorsCreateRequest(plan *[]HttpTransactionExtractorModel) *[]httpTransactionExtractorResponse {
+	if plan == nil {
+		return nil
+	}
+
+	result := make([]httpTransactionExtractorResponse, len(*plan))
+	for i, extractor := range *plan {
+		result[i] = httpTransactionExtractorResponse{
+			Variable: extractor.Variable.ValueString(),
+			JSONPath: extractor.JSONPath.ValueStringPointer(),
+			Regex:    extractor.Regex.ValueStringPointer(),
+			Header:   extractor.Header.ValueStringPointer(),
 		}
-		headers[k] = str.ValueString()
 	}
 
-	return &headers
+	return &result
+}
+
+func mapHttpTransactionAssertionsCreateRequest(plan *[]HttpTransactionAssertionModel) *[]httpTransactionAssertionResponse {
+	if plan == nil {
+		return nil
+	}
+
+	result := make([]httpTransactionAssertionResponse, len(*plan))
+	for i, assertion := range *plan {
+		result[i] = httpTransactionAssertionResponse{
+			Source:     assertion.Source.ValueString(),
+			Property:   assertion.Property.ValueStringPointer(),
+			Comparison: assertion.Comparison.ValueString(),
+			Value:      assertion.Value.ValueString(),
+		}
+	}
+
+	return &result
 }
 
 func mapWebhookAuthenticationCreateRequest(plan *WebhookAuthenticationModel) *webhookAuthenticationResponse {
@@ -269,8 +489,24 @@ func mapWebhookAuthenticationCreateRequest(plan *WebhookAuthenticationModel) *we
 	}
 
 	return &webhookAuthenticationResponse{
-		Type:   plan.Type.ValueString(),
-		Bearer: mapWebhookAuthenticationBearerCreateRequest(plan.Bearer),
+		Type:               plan.Type.ValueStringPointer(),
+		Bearer:             mapWebhookAuthenticationBearerCreateRequest(plan.Bearer),
+		Hmac:               mapWebhookAuthenticationHmacCreateRequest(plan.Hmac),
+		AllowedSourceCidrs: ListToStringArray(plan.AllowedSourceCidrs),
+	}
+}
+
+func mapWebhookAuthenticationHmacCreateRequest(plan *HmacModel) *webhookAuthenticationHmacResponse {
+	if plan == nil {
+		return nil
+	}
+
+	return &webhookAuthenticationHmacResponse{
+		SignatureHeader:             plan.SignatureHeader.ValueString(),
+		Algorithm:                   plan.Algorithm.ValueString(),
+		Secret:                      plan.Secret.ValueStringPointer(),
+		TimestampHeader:             plan.TimestampHeader.ValueStringPointer(),
+		TimestampToleranceInSeconds: plan.TimestampToleranceInSeconds.ValueInt64Pointer(),
 	}
 }
 
@@ -328,6 +564,7 @@ func (c *AllQuietAPIClient) CreateIntegrationResource(ctx context.Context, plan
 		TeamId:                plan.TeamId.ValueString(),
 		Labels:                ListToStringArray(plan.Labels),
 		IsMuted:               plan.IsMuted.ValueBool(),
+		MutedUntil:            plan.MutedUntil.ValueStringPointer(),
 		IsInMaintenance:       plan.IsInMaintenance.ValueBool(),
 		Type:                  plan.Type.ValueString(),
 		SnoozeSettings:        mapSnoozeSettingsCreateRequest(plan.SnoozeSettings),
@@ -393,6 +630,33 @@ func (c *AllQuietAPIClient) UpdateIntegrationResource(ctx context.Context, id st
 	return &result, nil
 }
 
+// RotateIntegrationWebhookUrl regenerates the webhook url of an integration. The previous url keeps working
+// for the grace period, if one is given.
+func (c *AllQuietAPIClient) RotateIntegrationWebhookUrl(ctx context.Context, id string, gracePeriodInMinutes *int64) (*integrationResponse, error) {
+	request := &integrationWebhookUrlRotationRequest{
+		GracePeriodInMinutes: gracePeriodInMinutes,
+	}
+
+	url := fmt.Sprintf("/inbound-integration/%s/regenerate-webhook-url", url.PathEscape(id))
+	httpResp, err := c.post(ctx, url, request)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, logErrorResponse(httpResp, request)
+	}
+
+	var result integrationResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
 func (c *AllQuietAPIClient) GetIntegrationResource(ctx context.Context, id string) (*integrationResponse, error) {
 	url := fmt.Sprintf("/inbound-integration/%s", url.PathEscape(id))
 	httpResp, err := c.get(ctx, url)
@@ -105,6 +105,10 @@ func (c *AllQuietAPIClient) GetIntegrationMaintenanceWindowResource(ctx context.
 	}
 	defer httpResp.Body.Close()
 
+	if httpResp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
 	if httpResp.StatusCode != http.StatusOK {
 		return nil, logErrorResponse(httpResp, nil)
 	}
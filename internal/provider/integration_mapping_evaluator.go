@@ -0,0 +1,211 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/AllQuietApp/terraform-provider-internal/internal/provider/validators"
+)
+
+// The integration mapping evaluator mirrors the pipeline All Quiet runs for every incoming payload, so
+// mappings can be tested offline. Each attribute starts with the raw payload and passes it through its
+// mappings in order, every mapping applying its xpath, json_path, regex (with replace), map and static
+// in that order. Expressions that do not match yield an empty value, invalid expressions an error and
+// expressions the evaluator does not support errUnsupportedMapping.
+//
+// JSONPath and XPath are supported in the subset used by mappings: child, recursive descent, wildcard and
+// index selectors for JSONPath, and child, descendant, attribute and text() steps with index and equality
+// predicates for XPath. Regular expressions use Go's syntax, which covers the .NET flavor except for
+// lookarounds, backreferences and a few other constructs, see validators.DotNetOnlyRegexConstruct.
+
+// errUnsupportedMapping is returned for JSONPath and XPath expressions beyond the supported subset and for
+// regexes using constructs only the .NET flavor supports.
+var errUnsupportedMapping = errors.New("mapping is not supported by the evaluator")
+
+// mappingStep is a single mapping of an attribute, see IntegrationMappingMappingModel.
+type mappingStep struct {
+	XPath    *string `json:"xpath"`
+	JSONPath *string `json:"json_path"`
+	Regex    *string `json:"regex"`
+	Replace  *string `json:"replace"`
+	Map      *string `json:"map"`
+	Static   *string `json:"static"`
+}
+
+// mappingAttribute is an attribute of an integration mapping, see IntegrationMappingAttributeModel.
+type mappingAttribute struct {
+	Name     string        `json:"name"`
+	Expand   bool          `json:"expand"`
+	Mappings []mappingStep `json:"mappings"`
+}
+
+// evaluateIntegrationMapping returns the incident attributes the mapping produces for the payload.
+// Attributes that evaluate to an empty value are left out.
+func evaluateIntegrationMapping(attributes []mappingAttribute, payload string) (map[string]string, error) {
+	result := map[string]string{}
+
+	for _, attribute := range attributes {
+		value := payload
+		for i, step := range attribute.Mappings {
+			var err error
+			value, err = evaluateMappingStep(step, value)
+			if err != nil {
+				return nil, fmt.Errorf("attribute %s, mapping %d: %w", attribute.Name, i, err)
+			}
+		}
+
+		if attribute.Expand {
+			for name, expanded := range expandMappingValue(attribute.Name, value) {
+				if expanded != "" {
+					result[name] = expanded
+				}
+			}
+			continue
+		}
+
+		if value != "" {
+			result[attribute.Name] = value
+		}
+	}
+
+	return result, nil
+}
+
+func evaluateMappingStep(step mappingStep, value string) (string, error) {
+	if step.XPath != nil {
+		path, err := validators.ParseXPath(*step.XPath)
+		if errors.Is(err, validators.ErrUnsupportedMappingExpression) {
+			return "", fmt.Errorf("%w: xpath %q: %s", errUnsupportedMapping, *step.XPath, err)
+		}
+		if err != nil {
+			return "", fmt.Errorf("invalid xpath %q: %w", *step.XPath, err)
+		}
+		value = path.Evaluate(value)
+	}
+
+	if step.JSONPath != nil {
+		path, err := validators.ParseJSONPath(*step.JSONPath)
+		if errors.Is(err, validators.ErrUnsupportedMappingExpression) {
+			return "", fmt.Errorf("%w: json_path %q: %s", errUnsupportedMapping, *step.JSONPath, err)
+		}
+		if err != nil {
+			return "", fmt.Errorf("invalid json_path %q: %w", *step.JSONPath, err)
+		}
+		value = path.Evaluate(value)
+	}
+
+	if step.Regex != nil {
+		re, err := regexp.Compile(*step.Regex)
+		if err != nil {
+			if construct := validators.DotNetOnlyRegexConstruct(*step.Regex); construct != "" {
+				return "", fmt.Errorf("%w: regex %q uses %s", errUnsupportedMapping, *step.Regex, construct)
+			}
+			return "", fmt.Errorf("invalid regex %q: %w", *step.Regex, err)
+		}
+		value = evaluateMappingRegex(re, step.Replace, value)
+	}
+
+	if step.Map != nil {
+		expression, err := validators.ParseMapExpression(*step.Map)
+		if err != nil {
+			return "", fmt.Errorf("invalid map %q: %w", *step.Map, err)
+		}
+		value = expression.Evaluate(value)
+	}
+
+	if step.Static != nil {
+		value = *step.Static
+	}
+
+	return value, nil
+}
+
+// evaluateMappingRegex returns the named group 'result' of the first match, the last group if there is
+// no such group, or the whole match if the regex has no groups. With replace, the replacement expanded
+// against the first match is returned instead.
+func evaluateMappingRegex(re *regexp.Regexp, replace *string, value string) string {
+	match := re.FindStringSubmatchIndex(value)
+	if match == nil {
+		return ""
+	}
+
+	if replace != nil {
+		return string(re.ExpandString(nil, dotNetReplacementToGo(*replace), value, match))
+	}
+
+	group := 0
+	if re.NumSubexp() > 0 {
+		group = re.SubexpIndex("result")
+		if group < 0 {
+			group = re.NumSubexp()
+		}
+	}
+
+	if match[2*group] < 0 {
+		return ""
+	}
+
+	return value[match[2*group]:match[2*group+1]]
+}
+
+// dotNetReplacementToGo rewrites numbered references like $1 to ${1}, as Go would otherwise read $1abc
+// as a reference to the group named '1abc'.
+func dotNetReplacementToGo(replace string) string {
+	var result strings.Builder
+	for i := 0; i < len(replace); i++ {
+		if replace[i] != '$' || i+1 >= len(replace) {
+			result.WriteByte(replace[i])
+			continue
+		}
+
+		if replace[i+1] == '$' {
+			result.WriteString("$$")
+			i++
+			continue
+		}
+
+		j := i + 1
+		for j < len(replace) && replace[j] >= '0' && replace[j] <= '9' {
+			j++
+		}
+		if j == i+1 {
+			result.WriteByte('$')
+			continue
+		}
+
+		result.WriteString("${" + replace[i+1:j] + "}")
+		i = j - 1
+	}
+
+	return result.String()
+}
+
+// expandMappingValue parses the value as JSON and returns an attribute per object key (name.key)
+// or array element (name[i]). Other values are returned as the attribute itself.
+func expandMappingValue(name string, value string) map[string]string {
+	document, ok := validators.DecodeJSONDocument(value)
+	if !ok {
+		return map[string]string{name: value}
+	}
+
+	result := map[string]string{}
+	switch typed := document.(type) {
+	case map[string]any:
+		for key, element := range typed {
+			result[name+"."+key] = validators.JSONValueToString(element)
+		}
+	case []any:
+		for i, element := range typed {
+			result[fmt.Sprintf("%s[%d]", name, i)] = validators.JSONValueToString(element)
+		}
+	default:
+		result[name] = value
+	}
+
+	return result
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// mappingPresetFiles is the library of integration mapping presets. Every preset is a directory with one
+// file per version (v1.json, v2.json, ...). Published versions are never changed, so that a pinned
+// version always expands to the same mapping.
+//
+//go:embed mapping_presets
+var mappingPresetFiles embed.FS
+
+const mappingPresetDir = "mapping_presets"
+
+var ValidIntegrationMappingPresets = listMappingPresets()
+
+type mappingPreset struct {
+	GroupingWindowInSeconds *int64                   `json:"grouping_window_in_seconds"`
+	Attributes              []mappingPresetAttribute `json:"attributes"`
+}
+
+type mappingPresetAttribute struct {
+	Name           string        `json:"name"`
+	IsImage        *bool         `json:"is_image"`
+	HideInPreviews *bool         `json:"hide_in_previews"`
+	IsGroupingKey  *bool         `json:"is_grouping_key"`
+	Expand         *bool         `json:"expand"`
+	Mappings       []mappingStep `json:"mappings"`
+}
+
+func listMappingPresets() []string {
+	entries, err := mappingPresetFiles.ReadDir(mappingPresetDir)
+	if err != nil {
+		panic(err)
+	}
+
+	var names []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+// mappingPresetVersions returns the versions of the preset, oldest first.
+func mappingPresetVersions(name string) []string {
+	entries, err := mappingPresetFiles.ReadDir(path.Join(mappingPresetDir, name))
+	if err != nil {
+		return nil
+	}
+
+	var versions []string
+	for _, entry := range entries {
+		version, ok := strings.CutSuffix(entry.Name(), ".json")
+		if ok && parseMappingPresetVersion(version) > 0 {
+			versions = append(versions, version)
+		}
+	}
+
+	slices.SortFunc(versions, func(a, b string) int {
+		return parseMappingPresetVersion(a) - parseMappingPresetVersion(b)
+	})
+	return versions
+}
+
+func parseMappingPresetVersion(version string) int {
+	number, err := strconv.Atoi(strings.TrimPrefix(version, "v"))
+	if err != nil || !strings.HasPrefix(version, "v") {
+		return 0
+	}
+	return number
+}
+
+func latestMappingPresetVersion(name string) string {
+	versions := mappingPresetVersions(name)
+	if len(versions) == 0 {
+		return ""
+	}
+	return versions[len(versions)-1]
+}
+
+func loadMappingPreset(name string, version string) (*mappingPreset, error) {
+	if !slices.Contains(mappingPresetVersions(name), version) {
+		return nil, fmt.Errorf("preset %s has no version %s, available versions are: %s", name, version, strings.Join(mappingPresetVersions(name), ", "))
+	}
+
+	data, err := mappingPresetFiles.ReadFile(path.Join(mappingPresetDir, name, version+".json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var preset mappingPreset
+	err = json.Unmarshal(data, &preset)
+	if err != nil {
+		return nil, fmt.Errorf("preset %s %s is invalid: %w", name, version, err)
+	}
+
+	return &preset, nil
+}
+
+// expandIntegrationMappingPreset returns the attributes mapping of the preset version with the
+// attributes of the preset model applied. Attributes replace the preset attribute of the same name
+// or are appended if the preset has none.
+func expandIntegrationMappingPreset(model *IntegrationMappingPresetModel, version string) (*IntegrationMappingAttributesMappingModel, error) {
+	preset, err := loadMappingPreset(model.Name.ValueString(), version)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &IntegrationMappingAttributesMappingModel{
+		GroupingWindowInSeconds: types.Int64PointerValue(preset.GroupingWindowInSeconds),
+		Attributes:              make([]IntegrationMappingAttributeModel, len(preset.Attributes)),
+	}
+
+	if !model.GroupingWindowInSeconds.IsNull() {
+		result.GroupingWindowInSeconds = model.GroupingWindowInSeconds
+	}
+
+	for i, attribute := range preset.Attributes {
+		result.Attributes[i] = mapMappingPresetAttributeToModel(attribute)
+	}
+
+	for _, attribute := range model.Attributes {
+		index := slices.IndexFunc(result.Attributes, func(a IntegrationMappingAttributeModel) bool {
+			return a.Name.Equal(attribute.Name)
+		})
+
+		if index >= 0 {
+			result.Attributes[index] = attribute
+		} else {
+			result.Attributes = append(result.Attributes, attribute)
+		}
+	}
+
+	return result, nil
+}
+
+func mapMappingPresetAttributeToModel(attribute mappingPresetAttribute) IntegrationMappingAttributeModel {
+	result := IntegrationMappingAttributeModel{
+		Name:           types.StringValue(attribute.Name),
+		IsImage:        types.BoolPointerValue(attribute.IsImage),
+		HideInPreviews: types.BoolPointerValue(attribute.HideInPreviews),
+		IsGroupingKey:  types.BoolPointerValue(attribute.IsGroupingKey),
+		Expand:         types.BoolPointerValue(attribute.Expand),
+		Mappings:       make([]IntegrationMappingMappingModel, len(attribute.Mappings)),
+	}
+
+	for i, mapping := range attribute.Mappings {
+		result.Mappings[i] = IntegrationMappingMappingModel{
+			XPath:    types.StringPointerValue(mapping.XPath),
+			JSONPath: types.StringPointerValue(mapping.JSONPath),
+			Regex:    types.StringPointerValue(mapping.Regex),
+			Replace:  types.StringPointerValue(mapping.Replace),
+			Map:      types.StringPointerValue(mapping.Map),
+			Static:   types.StringPointerValue(mapping.Static),
+		}
+	}
+
+	return result
+}
@@ -5,20 +5,29 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &IntegrationMapping{}
 var _ resource.ResourceWithImportState = &IntegrationMapping{}
+var _ resource.ResourceWithValidateConfig = &IntegrationMapping{}
+var _ resource.ResourceWithModifyPlan = &IntegrationMapping{}
 
 func NewIntegrationMapping() resource.Resource {
 	return &IntegrationMapping{}
@@ -34,6 +43,8 @@ type IntegrationMappingModel struct {
 	Id                types.String                              `tfsdk:"id"`
 	IntegrationId     types.String                              `tfsdk:"integration_id"`
 	AttributesMapping *IntegrationMappingAttributesMappingModel `tfsdk:"attributes_mapping"`
+	Preset            *IntegrationMappingPresetModel            `tfsdk:"preset"`
+	TestPayloads      []IntegrationMappingTestPayloadModel      `tfsdk:"test_payloads"`
 }
 
 type IntegrationMappingAttributesMappingModel struct {
@@ -61,6 +72,19 @@ type IntegrationMappingMappingModel struct {
 	Static   types.String `tfsdk:"static"`
 }
 
+type IntegrationMappingPresetModel struct {
+	Name                    types.String                       `tfsdk:"name"`
+	Version                 types.String                       `tfsdk:"version"`
+	GroupingWindowInSeconds types.Int64                        `tfsdk:"grouping_window_in_seconds"`
+	Attributes              []IntegrationMappingAttributeModel `tfsdk:"attributes"`
+}
+
+type IntegrationMappingTestPayloadModel struct {
+	Payload            types.String `tfsdk:"payload"`
+	ExpectedAttributes types.Map    `tfsdk:"expected_attributes"`
+	WarnOnly           types.Bool   `tfsdk:"warn_only"`
+}
+
 func (r *IntegrationMapping) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_integration_mapping"
 }
@@ -84,7 +108,7 @@ func (r *IntegrationMapping) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Id of the associated integration",
 			},
 			"attributes_mapping": schema.SingleNestedAttribute{
-				MarkdownDescription: "The attributes mapping of the integration",
+				MarkdownDescription: "The attributes mapping of the integration. Exactly one of `attributes_mapping` or `preset` must be configured.",
 				Optional:            true,
 				Computed:            true,
 				Attributes: map[string]schema.Attribute{
@@ -93,62 +117,121 @@ func (r *IntegrationMapping) Schema(ctx context.Context, req resource.SchemaRequ
 						Optional:            true,
 					},
 					"attributes": schema.ListNestedAttribute{
-						Required: true,
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"name": schema.StringAttribute{
-									MarkdownDescription: "The name of the attribute",
-									Required:            true,
-								},
-								"is_image": schema.BoolAttribute{
-									MarkdownDescription: "Whether the attribute is an image",
-									Optional:            true,
-								},
-								"hide_in_previews": schema.BoolAttribute{
-									MarkdownDescription: "Whether the attribute is hidden in previews",
-									Optional:            true,
-								},
-								"is_grouping_key": schema.BoolAttribute{
-									MarkdownDescription: "Whether the attribute is a grouping key",
-									Optional:            true,
-								},
-								"expand": schema.BoolAttribute{
-									MarkdownDescription: "When true, after all mapping steps the pipeline value is parsed as JSON; object keys and array indices become separate incident attributes (e.g. Name.key or Name[i]).",
-									Optional:            true,
-								},
-								"mappings": schema.ListNestedAttribute{
-									Required:            true,
-									MarkdownDescription: "The attribute's mappings",
-									NestedObject: schema.NestedAttributeObject{
-										Attributes: map[string]schema.Attribute{
-											"xpath": schema.StringAttribute{
-												MarkdownDescription: "A XPath expression to map HTML or XML. ( [w3schools](https://www.w3schools.com/xml/xpath_intro.asp))",
-												Optional:            true,
-											},
-											"json_path": schema.StringAttribute{
-												MarkdownDescription: "A JSONPath expression to map JSON ([goessner.net/articles/JsonPath](https://goessner.net/articles/JsonPath/))",
-												Optional:            true,
-											},
-											"regex": schema.StringAttribute{
-												MarkdownDescription: "A regular expression to extract parts of text. The regex is evaluated with the .NET/C# flavor. If groups are matched, the named group 'result' is returned. If no group is named 'result' the last group is returned. If no groups are found the whole match is returned. ( regex101.com)",
-												Optional:            true,
-											},
-											"replace": schema.StringAttribute{
-												MarkdownDescription: "Works together with the regex. Example: you could use the regex '(\\d+) and the replace value 'https://sentry.io/issues/$1/' to create a link to a Sentry issue.",
-												Optional:            true,
-											},
-											"map": schema.StringAttribute{
-												MarkdownDescription: "A simple map expression mapping values from A to B. The expression A->1,B->2,->3 will map the value 'A' to '1' and 'B' to '2' and fallback to '3' if no match is found. You can also omit the fallback. The result will then evaluate to the original value.",
-												Optional:            true,
-											},
-											"static": schema.StringAttribute{
-												MarkdownDescription: "A static string. The result will always be this string.",
-												Optional:            true,
-											},
-										},
-									},
-								},
-							},
+						Required:     true,
+						NestedObject: integrationMappingAttributeSchema(),
+					},
+				},
+			},
+			"preset": schema.SingleNestedAttribute{
+				MarkdownDescription: "A built-in mapping for a common alert source the `attributes_mapping` is expanded from. The plan shows the fully expanded mapping. Exactly one of `preset` or `attributes_mapping` must be configured.",
+				Optional:            true,
+				Validators: []validator.Object{
+					objectvalidator.ExactlyOneOf(path.MatchRoot("attributes_mapping")),
+				},
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The name of the preset. Possible values are: " + strings.Join(ValidIntegrationMappingPresets, ", "),
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.OneOf(ValidIntegrationMappingPresets...)},
+					},
+					"version": schema.StringAttribute{
+						MarkdownDescription: "The version of the preset, e.g. v1. Defaults to the latest version when the preset is first applied, which is kept until the version is set explicitly.",
+						Optional:            true,
+						Computed:            true,
+					},
+					"grouping_window_in_seconds": schema.Int64Attribute{
+						MarkdownDescription: "Overrides the grouping window in seconds of the preset",
+						Optional:            true,
+					},
+					"attributes": schema.ListNestedAttribute{
+						MarkdownDescription: "Attributes that replace the preset attribute of the same name or are appended to the preset's attributes",
+						Optional:            true,
+						NestedObject:        integrationMappingAttributeSchema(),
+					},
+				},
+			},
+			"test_payloads": schema.ListNestedAttribute{
+				MarkdownDescription: "Payloads the mapping is evaluated against at plan time, without sending them to All Quiet. " +
+					"The plan fails if an expected attribute does not come out as expected, or if the `Severity` or `Status` attribute is mapped to a value All Quiet does not accept. " +
+					"See the `evaluate_mapping` function for the supported expressions.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"payload": schema.StringAttribute{
+							MarkdownDescription: "The payload, e.g. from `jsonencode`",
+							Required:            true,
+						},
+						"expected_attributes": schema.MapAttribute{
+							MarkdownDescription: "The attributes the payload is expected to be mapped to. Attributes not listed are not checked. An empty value expects the attribute to be left out.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+						"warn_only": schema.BoolAttribute{
+							MarkdownDescription: "If true, unexpected attributes are reported as warnings instead of failing the plan.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func integrationMappingAttributeSchema() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the attribute",
+				Required:            true,
+			},
+			"is_image": schema.BoolAttribute{
+				MarkdownDescription: "Whether the attribute is an image",
+				Optional:            true,
+			},
+			"hide_in_previews": schema.BoolAttribute{
+				MarkdownDescription: "Whether the attribute is hidden in previews",
+				Optional:            true,
+			},
+			"is_grouping_key": schema.BoolAttribute{
+				MarkdownDescription: "Whether the attribute is a grouping key",
+				Optional:            true,
+			},
+			"expand": schema.BoolAttribute{
+				MarkdownDescription: "When true, after all mapping steps the pipeline value is parsed as JSON; object keys and array indices become separate incident attributes (e.g. Name.key or Name[i]).",
+				Optional:            true,
+			},
+			"mappings": schema.ListNestedAttribute{
+				Required:            true,
+				MarkdownDescription: "The attribute's mappings",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"xpath": schema.StringAttribute{
+							MarkdownDescription: "A XPath expression to map HTML or XML. ( [w3schools](https://www.w3schools.com/xml/xpath_intro.asp))",
+							Optional:            true,
+							Validators:          []validator.String{XPathValidator("Not a valid XPath expression")},
+						},
+						"json_path": schema.StringAttribute{
+							MarkdownDescription: "A JSONPath expression to map JSON ([goessner.net/articles/JsonPath](https://goessner.net/articles/JsonPath/))",
+							Optional:            true,
+							Validators:          []validator.String{JSONPathValidator("Not a valid JSONPath expression")},
+						},
+						"regex": schema.StringAttribute{
+							MarkdownDescription: "A regular expression to extract parts of text. The regex is evaluated with the .NET/C# flavor. If groups are matched, the named group 'result' is returned. If no group is named 'result' the last group is returned. If no groups are found the whole match is returned. ( regex101.com)",
+							Optional:            true,
+							Validators:          []validator.String{MappingRegexValidator("Not a valid regular expression")},
+						},
+						"replace": schema.StringAttribute{
+							MarkdownDescription: "Works together with the regex. Example: you could use the regex '(\\d+) and the replace value 'https://sentry.io/issues/$1/' to create a link to a Sentry issue.",
+							Optional:            true,
+						},
+						"map": schema.StringAttribute{
+							MarkdownDescription: "A simple map expression mapping values from A to B. The expression A->1,B->2,->3 will map the value 'A' to '1' and 'B' to '2' and fallback to '3' if no match is found. You can also omit the fallback. The result will then evaluate to the original value.",
+							Optional:            true,
+							Validators:          []validator.String{MapExpressionValidator("Not a valid map expression")},
+						},
+						"static": schema.StringAttribute{
+							MarkdownDescription: "A static string. The result will always be this string.",
+							Optional:            true,
 						},
 					},
 				},
@@ -157,6 +240,151 @@ func (r *IntegrationMapping) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+func (r *IntegrationMapping) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var attributesMapping types.Object
+	var preset types.Object
+	var testPayloads types.List
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("attributes_mapping"), &attributesMapping)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("preset"), &preset)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("test_payloads"), &testPayloads)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var presetModel IntegrationMappingPresetModel
+	presetKnown := !preset.IsNull() && !preset.IsUnknown() &&
+		!preset.As(ctx, &presetModel, basetypes.ObjectAsOptions{}).HasError() &&
+		!presetModel.Name.IsUnknown() && !presetModel.Version.IsUnknown() && !presetModel.GroupingWindowInSeconds.IsUnknown()
+
+	if presetKnown && !presetModel.Version.IsNull() {
+		versions := mappingPresetVersions(presetModel.Name.ValueString())
+		if !slices.Contains(versions, presetModel.Version.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("preset").AtName("version"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("Preset %s has no version %s, available versions are: %s", presetModel.Name.ValueString(), presetModel.Version.ValueString(), strings.Join(versions, ", ")),
+			)
+			return
+		}
+	}
+
+	if testPayloads.IsNull() || testPayloads.IsUnknown() {
+		return
+	}
+
+	// The mapping can only be evaluated once it is fully known
+	var mapping *IntegrationMappingAttributesMappingModel
+	if preset.IsNull() {
+		if attributesMapping.IsNull() || attributesMapping.IsUnknown() {
+			return
+		}
+
+		if diags := attributesMapping.As(ctx, &mapping, basetypes.ObjectAsOptions{}); diags.HasError() {
+			return
+		}
+	} else {
+		if !presetKnown {
+			return
+		}
+
+		version := presetModel.Version.ValueString()
+		if presetModel.Version.IsNull() {
+			version = latestMappingPresetVersion(presetModel.Name.ValueString())
+		}
+
+		expanded, err := expandIntegrationMappingPreset(&presetModel, version)
+		if err != nil {
+			return
+		}
+		mapping = expanded
+	}
+
+	attributes, ok := mapIntegrationMappingModelToEvaluator(mapping.Attributes)
+	if !ok {
+		return
+	}
+
+	var payloads []IntegrationMappingTestPayloadModel
+	if diags := testPayloads.ElementsAs(ctx, &payloads, false); diags.HasError() {
+		return
+	}
+
+	for i, payload := range payloads {
+		payloadPath := path.Root("test_payloads").AtListIndex(i)
+		if payload.Payload.IsUnknown() || payload.ExpectedAttributes.IsUnknown() {
+			continue
+		}
+
+		result, err := evaluateIntegrationMapping(attributes, payload.Payload.ValueString())
+		if errors.Is(err, errUnsupportedMapping) {
+			resp.Diagnostics.AddAttributeWarning(payloadPath.AtName("payload"), "Test Payload Not Evaluated", fmt.Sprintf("Unable to evaluate mapping, got error: %s", err))
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(payloadPath.AtName("payload"), "Invalid Integration Mapping", fmt.Sprintf("Unable to evaluate mapping, got error: %s", err))
+			continue
+		}
+
+		for _, mismatch := range checkIntegrationMappingResult(attributes, result, MapToStringMap(payload.ExpectedAttributes)) {
+			if payload.WarnOnly.ValueBool() {
+				resp.Diagnostics.AddAttributeWarning(payloadPath, "Unexpected Mapping Result", mismatch)
+			} else {
+				resp.Diagnostics.AddAttributeError(payloadPath, "Unexpected Mapping Result", mismatch)
+			}
+		}
+	}
+}
+
+func (r *IntegrationMapping) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to expand when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var preset types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("preset"), &preset)...)
+	if resp.Diagnostics.HasError() || preset.IsNull() {
+		return
+	}
+
+	var model IntegrationMappingPresetModel
+	if preset.IsUnknown() || preset.As(ctx, &model, basetypes.ObjectAsOptions{}).HasError() || model.Name.IsUnknown() || model.Version.IsUnknown() {
+		attributesMappingType, diags := req.Plan.Schema.TypeAtPath(ctx, path.Root("attributes_mapping"))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("attributes_mapping"), types.ObjectUnknown(attributesMappingType.(types.ObjectType).AttrTypes))...)
+		return
+	}
+
+	// Without an explicit version, the version the preset was first applied with is kept
+	version := model.Version.ValueString()
+	if model.Version.IsNull() {
+		version = latestMappingPresetVersion(model.Name.ValueString())
+
+		var statePreset *IntegrationMappingPresetModel
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("preset"), &statePreset)...)
+		}
+
+		if statePreset != nil && statePreset.Name.Equal(model.Name) && !statePreset.Version.IsNull() {
+			version = statePreset.Version.ValueString()
+		}
+	}
+
+	expanded, err := expandIntegrationMappingPreset(&model, version)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("preset"), "Invalid Preset", fmt.Sprintf("Unable to expand preset, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("preset").AtName("version"), types.StringValue(version))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("attributes_mapping"), expanded)...)
+}
+
 func (r *IntegrationMapping) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -300,3 +528,77 @@ func mapIntegrationMappingResponseToModel(response *integrationMappingResponse,
 		}
 	}
 }
+
+// mapIntegrationMappingModelToEvaluator returns false if a value of the mapping is not known yet.
+func mapIntegrationMappingModelToEvaluator(attributes []IntegrationMappingAttributeModel) ([]mappingAttribute, bool) {
+	result := make([]mappingAttribute, len(attributes))
+
+	for i, attribute := range attributes {
+		if attribute.Name.IsUnknown() || attribute.Expand.IsUnknown() {
+			return nil, false
+		}
+
+		result[i] = mappingAttribute{
+			Name:     attribute.Name.ValueString(),
+			Expand:   attribute.Expand.ValueBool(),
+			Mappings: make([]mappingStep, len(attribute.Mappings)),
+		}
+
+		for j, mapping := range attribute.Mappings {
+			values := []types.String{mapping.XPath, mapping.JSONPath, mapping.Regex, mapping.Replace, mapping.Map, mapping.Static}
+			for _, value := range values {
+				if value.IsUnknown() {
+					return nil, false
+				}
+			}
+
+			result[i].Mappings[j] = mappingStep{
+				XPath:    mapping.XPath.ValueStringPointer(),
+				JSONPath: mapping.JSONPath.ValueStringPointer(),
+				Regex:    mapping.Regex.ValueStringPointer(),
+				Replace:  mapping.Replace.ValueStringPointer(),
+				Map:      mapping.Map.ValueStringPointer(),
+				Static:   mapping.Static.ValueStringPointer(),
+			}
+		}
+	}
+
+	return result, true
+}
+
+// checkIntegrationMappingResult compares the evaluated attributes with the expected ones and checks that
+// Severity and Status, if mapped, come out as values All Quiet accepts.
+func checkIntegrationMappingResult(attributes []mappingAttribute, result map[string]string, expected *map[string]string) []string {
+	var mismatches []string
+
+	allowed := map[string][]string{
+		"Severity": ValidSeverities,
+		"Status":   ValidStatuses,
+	}
+	for _, attribute := range attributes {
+		values, ok := allowed[attribute.Name]
+		if !ok {
+			continue
+		}
+
+		if value := result[attribute.Name]; !slices.Contains(values, value) {
+			mismatches = append(mismatches, fmt.Sprintf("Attribute %s was mapped to %q, expected one of: %s", attribute.Name, value, strings.Join(values, ", ")))
+		}
+	}
+
+	if expected != nil {
+		names := make([]string, 0, len(*expected))
+		for name := range *expected {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+
+		for _, name := range names {
+			if value := result[name]; value != (*expected)[name] {
+				mismatches = append(mismatches, fmt.Sprintf("Attribute %s was mapped to %q, expected %q", name, value, (*expected)[name]))
+			}
+		}
+	}
+
+	return mismatches
+}
@@ -4,8 +4,10 @@
 package provider
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -53,11 +55,12 @@ func TestAccIntegrationMappingResourceExample(t *testing.T) {
 					resource.TestCheckResourceAttrSet("allquiet_integration_mapping.datadog_custom_mapping", "integration_id"),
 				),
 			},
-			// ImportState testing
+			// ImportState testing, test payloads are only known to Terraform
 			{
-				ResourceName:      "allquiet_integration_mapping.datadog_custom_mapping",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "allquiet_integration_mapping.datadog_custom_mapping",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"test_payloads"},
 			},
 			// Update and Read testing
 			{
@@ -124,6 +127,248 @@ resource "allquiet_integration_mapping" "test" {
 
 }
 
+func TestAccIntegrationMappingResourceTestPayloads(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Expected attribute does not match
+			{
+				Config:      testAccIntegrationMappingResourceTestPayloadsConfig(`{ Severity = "Warning" }`, false),
+				ExpectError: regexp.MustCompile(`Attribute Severity was mapped to "Critical", expected "Warning"`),
+			},
+			// Severity is not mapped to a valid severity
+			{
+				Config:      testAccIntegrationMappingResourceTestPayloadsConfig(`{}`, false),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute Severity was mapped to "", expected one of`),
+			},
+			// Mismatches are only reported as warnings
+			{
+				Config: testAccIntegrationMappingResourceTestPayloadsConfig(`{ Severity = "Warning" }`, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "test_payloads.#", "2"),
+				),
+			},
+			// Matching expectations
+			{
+				Config: testAccIntegrationMappingResourceTestPayloadsConfig(`{ Severity = "Critical", Link = "https://sentry.io/issues/4711/" }`, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "test_payloads.0.expected_attributes.Severity", "Critical"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationMappingResourceTestPayloadsConfig(expectedAttributes string, warnOnly bool) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "test" {
+  display_name = "My Datadog Integration"
+  team_id      = allquiet_team.test.id
+  type         = "Datadog"
+}
+
+resource "allquiet_integration_mapping" "test" {
+  integration_id = allquiet_integration.test.id
+  attributes_mapping = {
+    attributes = [
+      {
+        name = "Severity"
+        mappings = [
+          { json_path = "$.jsonBody.priority" },
+          { map = "P1->Critical,P2->Warning" }
+        ]
+      },
+      {
+        name = "Link"
+        mappings = [
+          { json_path = "$.jsonBody.message" },
+          { regex = "issue (\\d+)", replace = "https://sentry.io/issues/$1/" }
+        ]
+      }
+    ]
+  }
+
+  test_payloads = [
+    {
+      payload             = jsonencode({ jsonBody = { priority = "P1", message = "New issue 4711" } })
+      expected_attributes = %[1]s
+      warn_only           = %[2]t
+    },
+    {
+      payload   = jsonencode({ jsonBody = { priority = "P2" } })
+      warn_only = %[2]t
+    }
+  ]
+}
+`, expectedAttributes, warnOnly)
+}
+
+func TestAccIntegrationMappingResourceInvalidExpressions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIntegrationMappingResourceMappingConfig(`{ json_path = "$.alerts[0.labels" }`),
+				ExpectError: regexp.MustCompile(`Invalid JSONPath Expression`),
+			},
+			{
+				Config:      testAccIntegrationMappingResourceMappingConfig(`{ xpath = "//alert[@id='1'" }`),
+				ExpectError: regexp.MustCompile(`Invalid XPath Expression`),
+			},
+			{
+				Config:      testAccIntegrationMappingResourceMappingConfig(`{ map = "A->Critical,A->Warning" }`),
+				ExpectError: regexp.MustCompile(`duplicate key "A"`),
+			},
+			{
+				Config:      testAccIntegrationMappingResourceMappingConfig(`{ map = "->Warning,A->Critical" }`),
+				ExpectError: regexp.MustCompile(`the fallback must be the last entry`),
+			},
+			{
+				Config:      testAccIntegrationMappingResourceMappingConfig(`{ regex = "(\\d+" }`),
+				ExpectError: regexp.MustCompile(`Invalid Regular Expression`),
+			},
+			// .NET-only constructs are only a warning
+			{
+				Config: testAccIntegrationMappingResourceMappingConfig(`{ regex = "(?<=issue )\\d+" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "attributes_mapping.attributes.0.mappings.0.regex", "(?<=issue )\\d+"),
+				),
+			},
+			// Well-formed XPath beyond the evaluated subset is only a warning
+			{
+				Config: testAccIntegrationMappingResourceMappingConfig(`{ xpath = "//alert[contains(., 'x')]" }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "attributes_mapping.attributes.0.mappings.0.xpath", "//alert[contains(., 'x')]"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationMappingResourceMappingConfig(mapping string) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "test" {
+  display_name = "My Datadog Integration"
+  team_id      = allquiet_team.test.id
+  type         = "Datadog"
+}
+
+resource "allquiet_integration_mapping" "test" {
+  integration_id = allquiet_integration.test.id
+  attributes_mapping = {
+    attributes = [
+      {
+        name     = "Title"
+        mappings = [%s]
+      }
+    ]
+  }
+}
+`, mapping)
+}
+
+func TestAccIntegrationMappingResourcePreset(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create with the latest version of the preset
+			{
+				Config: testAccIntegrationMappingResourcePresetConfig("sentry", "null", "[]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "preset.version", "v1"),
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "attributes_mapping.attributes.0.name", "Title"),
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "attributes_mapping.attributes.0.mappings.0.json_path", "$.jsonBody.data.event.title"),
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "attributes_mapping.attributes.#", "7"),
+				),
+			},
+			// ImportState testing, the preset is only known to Terraform
+			{
+				ResourceName:            "allquiet_integration_mapping.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"preset"},
+			},
+			// Override and append attributes
+			{
+				Config: testAccIntegrationMappingResourcePresetConfig("sentry", `"v1"`, `[
+      { name = "Status", mappings = [{ json_path = "$.jsonBody.action" }, { map = "resolved->Resolved,->Open" }] },
+      { name = "Release", mappings = [{ json_path = "$.jsonBody.data.event.release" }] }
+    ]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "attributes_mapping.attributes.#", "8"),
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "attributes_mapping.attributes.2.name", "Status"),
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "attributes_mapping.attributes.2.mappings.0.json_path", "$.jsonBody.action"),
+					resource.TestCheckResourceAttr("allquiet_integration_mapping.test", "attributes_mapping.attributes.7.name", "Release"),
+				),
+			},
+			// Unknown version
+			{
+				Config:      testAccIntegrationMappingResourcePresetConfig("sentry", `"v99"`, "[]"),
+				ExpectError: regexp.MustCompile(`Preset sentry has no version v99`),
+			},
+			// Neither preset nor attributes mapping
+			{
+				Config:      testAccIntegrationMappingResourceNoMappingConfig(),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccIntegrationMappingResourcePresetConfig(name string, version string, attributes string) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "test" {
+  display_name = "My Sentry Integration"
+  team_id      = allquiet_team.test.id
+  type         = "Webhook"
+}
+
+resource "allquiet_integration_mapping" "test" {
+  integration_id = allquiet_integration.test.id
+
+  preset = {
+    name       = %[1]q
+    version    = %[2]s
+    attributes = %[3]s
+  }
+}
+`, name, version, attributes)
+}
+
+func testAccIntegrationMappingResourceNoMappingConfig() string {
+	return `
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "test" {
+  display_name = "My Sentry Integration"
+  team_id      = allquiet_team.test.id
+  type         = "Webhook"
+}
+
+resource "allquiet_integration_mapping" "test" {
+  integration_id = allquiet_integration.test.id
+}
+`
+}
+
 func testAccIntegrationMappingResourceExample() string {
 	absPath, _ := filepath.Abs("../../examples/resources/allquiet_integration_mapping/resource.tf")
 
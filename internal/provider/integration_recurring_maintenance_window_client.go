@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/AllQuietApp/terraform-provider-internal/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (c *AllQuietAPIClient) GetIntegrationRecurringMaintenanceWindows(ctx context.Context, windows []RecurrenceOccurrenceModel) ([]integrationMaintenanceWindowResponse, error) {
+	var result []integrationMaintenanceWindowResponse
+	for _, window := range windows {
+		response, err := c.GetIntegrationMaintenanceWindowResource(ctx, window.Id.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		// Windows deleted outside of Terraform are recreated on the next apply
+		if response == nil {
+			continue
+		}
+
+		result = append(result, *response)
+	}
+
+	return result, nil
+}
+
+// SyncIntegrationRecurringMaintenanceWindows applies the changes planned by planRecurrenceChanges.
+// Kept windows are only updated if updateKept is set. On error, the windows that exist at that point are
+// returned together with the error, so they can be kept in the state.
+func (c *AllQuietAPIClient) SyncIntegrationRecurringMaintenanceWindows(ctx context.Context, data *IntegrationRecurringMaintenanceWindowModel, changes recurrenceChanges, updateKept bool) ([]integrationMaintenanceWindowResponse, error) {
+	var result []integrationMaintenanceWindowResponse
+
+	for i, window := range changes.Keep {
+		if !updateKept {
+			result = append(result, mapRecurringMaintenanceWindowModelToResponse(window))
+			continue
+		}
+
+		occurrence, err := mapRecurrenceOccurrenceModel(window)
+		if err == nil {
+			var response *integrationMaintenanceWindowResponse
+			response, err = c.UpdateIntegrationMaintenanceWindowResource(ctx, window.Id.ValueString(), mapMaintenanceWindowOccurrence(data, occurrence))
+			if err == nil {
+				result = append(result, *response)
+				continue
+			}
+		}
+
+		for _, remaining := range append(slices.Clone(changes.Keep[i:]), changes.Delete...) {
+			result = append(result, mapRecurringMaintenanceWindowModelToResponse(remaining))
+		}
+		return result, err
+	}
+
+	for i, window := range changes.Delete {
+		err := c.DeleteIntegrationMaintenanceWindowResource(ctx, window.Id.ValueString())
+		if err != nil {
+			for _, remaining := range changes.Delete[i:] {
+				result = append(result, mapRecurringMaintenanceWindowModelToResponse(remaining))
+			}
+			return result, err
+		}
+	}
+
+	for _, occurrence := range changes.Create {
+		response, err := c.CreateIntegrationMaintenanceWindowResource(ctx, mapMaintenanceWindowOccurrence(data, occurrence))
+		if err != nil {
+			return result, err
+		}
+
+		result = append(result, *response)
+	}
+
+	return result, nil
+}
+
+func mapRecurringMaintenanceWindowModelToResponse(window RecurrenceOccurrenceModel) integrationMaintenanceWindowResponse {
+	return integrationMaintenanceWindowResponse{Id: window.Id.ValueString(), Start: window.Start.ValueStringPointer(), End: window.End.ValueStringPointer()}
+}
+
+func (c *AllQuietAPIClient) DeleteIntegrationRecurringMaintenanceWindows(ctx context.Context, windows []RecurrenceOccurrenceModel) error {
+	for _, window := range windows {
+		err := c.DeleteIntegrationMaintenanceWindowResource(ctx, window.Id.ValueString())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func mapMaintenanceWindowOccurrence(data *IntegrationRecurringMaintenanceWindowModel, occurrence recurrenceOccurrence) *IntegrationMaintenanceWindowModel {
+	return &IntegrationMaintenanceWindowModel{
+		IntegrationId: data.IntegrationId,
+		Start:         types.StringValue(occurrence.Start.UTC().Format(validators.DateTimeLayout)),
+		End:           types.StringValue(occurrence.End.UTC().Format(validators.DateTimeLayout)),
+		Description:   data.Description,
+		Type:          data.Type,
+	}
+}
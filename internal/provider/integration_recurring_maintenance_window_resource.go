@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IntegrationRecurringMaintenanceWindow{}
+var _ resource.ResourceWithModifyPlan = &IntegrationRecurringMaintenanceWindow{}
+
+func NewIntegrationRecurringMaintenanceWindow() resource.Resource {
+	return &IntegrationRecurringMaintenanceWindow{}
+}
+
+// IntegrationRecurringMaintenanceWindow defines the resource implementation.
+type IntegrationRecurringMaintenanceWindow struct {
+	client *AllQuietAPIClient
+}
+
+// IntegrationRecurringMaintenanceWindowModel describes the resource data model.
+type IntegrationRecurringMaintenanceWindowModel struct {
+	Id                types.String            `tfsdk:"id"`
+	IntegrationId     types.String            `tfsdk:"integration_id"`
+	Description       types.String            `tfsdk:"description"`
+	Type              types.String            `tfsdk:"type"`
+	TimeZoneId        types.String            `tfsdk:"time_zone_id"`
+	WeeklySchedules   []WeeklyRecurrenceModel `tfsdk:"weekly_schedules"`
+	WindowHorizonDays types.Int64             `tfsdk:"window_horizon_days"`
+	Windows           types.List              `tfsdk:"windows"`
+}
+
+func (r *IntegrationRecurringMaintenanceWindow) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration_recurring_maintenance_window"
+}
+
+func (r *IntegrationRecurringMaintenanceWindow) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The `integration_recurring_maintenance_window` resource represents a recurring maintenance window of an integration in All Quiet. " +
+			"The provider expands the weekly schedules into concrete maintenance windows for the next `window_horizon_days` days. " +
+			"Every apply rolls the horizon forward: upcoming windows are created, and windows that have ended are kept in All Quiet as history but are no longer managed by this resource. " +
+			"Run `terraform apply` regularly, at least once per horizon, so that windows are always scheduled.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"integration_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the associated integration",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description of the maintenance windows",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Type of the maintenance windows. Possible values are: " + strings.Join(ValidMaintenanceWindowTypes, ", "),
+				Validators: []validator.String{
+					stringvalidator.OneOf(ValidMaintenanceWindowTypes...),
+				},
+			},
+			"time_zone_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The time zone id the weekly schedules are defined in. Find all timezone ids [here](https://allquiet.app/api/public/v1/timezone) or use the `allquiet_time_zones` data source",
+				Validators:          []validator.String{TimeZoneValidator("Not a valid time zone id")},
+			},
+			"weekly_schedules": WeeklyRecurrenceSchemaAttribute("maintenance window"),
+			"window_horizon_days": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Number of days ahead for which maintenance windows are scheduled. Defaults to 28.",
+				Default:             int64default.StaticInt64(28),
+				Validators:          []validator.Int64{int64validator.Between(1, 365)},
+			},
+			"windows": RecurrenceOccurrencesSchemaAttribute("maintenance window"),
+		},
+	}
+}
+
+func (r *IntegrationRecurringMaintenanceWindow) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AllQuietAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IntegrationRecurringMaintenanceWindow) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on create or destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state IntegrationRecurringMaintenanceWindowModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Windows.IsUnknown() || plan.TimeZoneId.IsUnknown() || plan.WindowHorizonDays.IsUnknown() {
+		return
+	}
+
+	occurrences, err := expandIntegrationRecurringMaintenanceWindow(&plan, time.Now())
+	if err != nil {
+		return
+	}
+
+	var windows []RecurrenceOccurrenceModel
+	resp.Diagnostics.Append(state.Windows.ElementsAs(ctx, &windows, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes, err := planRecurrenceChanges(windows, occurrences, time.Now(), false)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to plan integration recurring maintenance window resource, got error: %s", err))
+		return
+	}
+
+	// The horizon has rolled forward or windows were deleted outside of Terraform, so the windows are synced on apply
+	if !changes.IsEmpty() {
+		plan.Windows = types.ListUnknown(recurrenceOccurrenceType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+}
+
+func (r *IntegrationRecurringMaintenanceWindow) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IntegrationRecurringMaintenanceWindowModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	occurrences, err := expandIntegrationRecurringMaintenanceWindow(&data, now)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration recurring maintenance window resource, got error: %s", err))
+		return
+	}
+
+	changes, err := planRecurrenceChanges(nil, occurrences, now, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration recurring maintenance window resource, got error: %s", err))
+		return
+	}
+
+	windowResponses, err := r.client.SyncIntegrationRecurringMaintenanceWindows(ctx, &data, changes, false)
+	if err != nil && len(windowResponses) == 0 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration recurring maintenance window resource, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(uuid.New().String())
+	resp.Diagnostics.Append(mapIntegrationRecurringMaintenanceWindowResponseToModel(ctx, windowResponses, now, &data)...)
+
+	// The windows created before the error are kept in the state, so they are not orphaned
+	if err != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration recurring maintenance window resource, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created integration recurring maintenance window resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationRecurringMaintenanceWindow) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IntegrationRecurringMaintenanceWindowModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var windows []RecurrenceOccurrenceModel
+	resp.Diagnostics.Append(data.Windows.ElementsAs(ctx, &windows, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windowResponses, err := r.client.GetIntegrationRecurringMaintenanceWindows(ctx, windows)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integration recurring maintenance window resource, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(mapIntegrationRecurringMaintenanceWindowResponseToModel(ctx, windowResponses, time.Now(), &data)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationRecurringMaintenanceWindow) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state IntegrationRecurringMaintenanceWindowModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var windows []RecurrenceOccurrenceModel
+	resp.Diagnostics.Append(state.Windows.ElementsAs(ctx, &windows, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	occurrences, err := expandIntegrationRecurringMaintenanceWindow(&data, now)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration recurring maintenance window resource, got error: %s", err))
+		return
+	}
+
+	updateKept := !data.Description.Equal(state.Description) || !data.Type.Equal(state.Type)
+	changes, err := planRecurrenceChanges(windows, occurrences, now, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration recurring maintenance window resource, got error: %s", err))
+		return
+	}
+
+	windowResponses, err := r.client.SyncIntegrationRecurringMaintenanceWindows(ctx, &data, changes, updateKept)
+	resp.Diagnostics.Append(mapIntegrationRecurringMaintenanceWindowResponseToModel(ctx, windowResponses, now, &data)...)
+
+	// The windows that exist after the error are kept in the state, so they are not orphaned
+	if err != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration recurring maintenance window resource, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated integration recurring maintenance window resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationRecurringMaintenanceWindow) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IntegrationRecurringMaintenanceWindowModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var windows []RecurrenceOccurrenceModel
+	resp.Diagnostics.Append(data.Windows.ElementsAs(ctx, &windows, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteIntegrationRecurringMaintenanceWindows(ctx, windows)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete integration recurring maintenance window resource, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted integration recurring maintenance window resource")
+}
+
+func expandIntegrationRecurringMaintenanceWindow(data *IntegrationRecurringMaintenanceWindowModel, now time.Time) ([]recurrenceOccurrence, error) {
+	location, err := time.LoadLocation(data.TimeZoneId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	return expandWeeklyRecurrence(data.WeeklySchedules, location, now, now.AddDate(0, 0, int(data.WindowHorizonDays.ValueInt64())))
+}
+
+func mapIntegrationRecurringMaintenanceWindowResponseToModel(ctx context.Context, responses []integrationMaintenanceWindowResponse, now time.Time, data *IntegrationRecurringMaintenanceWindowModel) diag.Diagnostics {
+	var windows []RecurrenceOccurrenceModel
+	for _, response := range responses {
+		windows = append(windows, RecurrenceOccurrenceModel{
+			Id:    types.StringValue(response.Id),
+			Start: types.StringPointerValue(response.Start),
+			End:   types.StringPointerValue(response.End),
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.Windows, diags = MapRecurrenceOccurrencesToList(ctx, windows, now)
+	return diags
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationRecurringMaintenanceWindowResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIntegrationRecurringMaintenanceWindowResourceConfig("My Maintenance Window", "[\"sun\"]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration_recurring_maintenance_window.test", "window_horizon_days", "14"),
+					resource.TestCheckResourceAttrSet("allquiet_integration_recurring_maintenance_window.test", "windows.0.id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccIntegrationRecurringMaintenanceWindowResourceConfig("My Updated Maintenance Window", "[\"sat\", \"sun\"]"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration_recurring_maintenance_window.test", "description", "My Updated Maintenance Window"),
+					resource.TestCheckResourceAttrSet("allquiet_integration_recurring_maintenance_window.test", "windows.3.id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIntegrationRecurringMaintenanceWindowResourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIntegrationRecurringMaintenanceWindowResourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("allquiet_integration_recurring_maintenance_window.weekly_patching", "windows.0.id"),
+					resource.TestCheckResourceAttrSet("allquiet_integration_recurring_maintenance_window.nightly_backup", "windows.0.id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIntegrationRecurringMaintenanceWindowResourceConfig(description string, selectedDays string) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "test" {
+  display_name = "My Datadog Integration"
+  team_id      = allquiet_team.test.id
+  type         = "Datadog"
+}
+
+resource "allquiet_integration_recurring_maintenance_window" "test" {
+  integration_id      = allquiet_integration.test.id
+  description         = %[1]q
+  type                = "maintenance"
+  time_zone_id        = "Europe/Zurich"
+  window_horizon_days = 14
+  weekly_schedules = [
+    {
+      selected_days = %[2]s
+      from          = "02:00"
+      until         = "04:00"
+    }
+  ]
+}
+`, description, selectedDays)
+}
+
+func testAccIntegrationRecurringMaintenanceWindowResourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/resources/allquiet_integration_recurring_maintenance_window/resource.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return RandomizeExample(string(dat))
+}
@@ -6,19 +6,26 @@ package provider
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/AllQuietApp/terraform-provider-internal/internal/provider/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,6 +33,8 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &Integration{}
 var _ resource.ResourceWithImportState = &Integration{}
+var _ resource.ResourceWithValidateConfig = &Integration{}
+var _ resource.ResourceWithModifyPlan = &Integration{}
 
 func NewIntegration() resource.Resource {
 	return &Integration{}
@@ -38,25 +47,34 @@ type Integration struct {
 
 // IntegrationModel describes the resource data model.
 type IntegrationModel struct {
-	Id                    types.String                `tfsdk:"id"`
-	DisplayName           types.String                `tfsdk:"display_name"`
-	TeamId                types.String                `tfsdk:"team_id"`
-	Labels                types.List                  `tfsdk:"labels"`
-	IsMuted               types.Bool                  `tfsdk:"is_muted"`
-	IsInMaintenance       types.Bool                  `tfsdk:"is_in_maintenance"`
-	Type                  types.String                `tfsdk:"type"`
-	WebhookUrl            types.String                `tfsdk:"webhook_url"`
-	SnoozeSettings        *SnoozeSettingsModel        `tfsdk:"snooze_settings"`
-	WebhookAuthentication *WebhookAuthenticationModel `tfsdk:"webhook_authentication"`
-	IntegrationSettings   *IntegrationSettingsModel   `tfsdk:"integration_settings"`
+	Id                                     types.String                `tfsdk:"id"`
+	DisplayName                            types.String                `tfsdk:"display_name"`
+	TeamId                                 types.String                `tfsdk:"team_id"`
+	Labels                                 types.List                  `tfsdk:"labels"`
+	IsMuted                                types.Bool                  `tfsdk:"is_muted"`
+	MutedUntil                             types.String                `tfsdk:"muted_until"`
+	MutedSince                             types.String                `tfsdk:"muted_since"`
+	IsInMaintenance                        types.Bool                  `tfsdk:"is_in_maintenance"`
+	Type                                   types.String                `tfsdk:"type"`
+	WebhookUrl                             types.String                `tfsdk:"webhook_url"`
+	WebhookUrlRotationTrigger              types.String                `tfsdk:"webhook_url_rotation_trigger"`
+	WebhookUrlRotationGracePeriodInMinutes types.Int64                 `tfsdk:"webhook_url_rotation_grace_period_in_minutes"`
+	PreviousWebhookUrlValidUntil           types.String                `tfsdk:"previous_webhook_url_valid_until"`
+	SnoozeSettings                         *SnoozeSettingsModel        `tfsdk:"snooze_settings"`
+	WebhookAuthentication                  *WebhookAuthenticationModel `tfsdk:"webhook_authentication"`
+	IntegrationSettings                    *IntegrationSettingsModel   `tfsdk:"integration_settings"`
 }
 
 type IntegrationSettingsModel struct {
-	HttpMonitoring   *HttpMonitoringModel   `tfsdk:"http_monitoring"`
-	HeartbeatMonitor *HeartbeatMonitorModel `tfsdk:"heartbeat_monitor"`
-	CronjobMonitor   *CronjobMonitorModel   `tfsdk:"cronjob_monitor"`
-	PingMonitor      *PingMonitorModel      `tfsdk:"ping_monitor"`
-	Email            *EmailSettingsModel    `tfsdk:"email"`
+	HttpMonitoring         *HttpMonitoringModel         `tfsdk:"http_monitoring"`
+	HttpTransactionMonitor *HttpTransactionMonitorModel `tfsdk:"http_transaction_monitor"`
+	HeartbeatMonitor       *HeartbeatMonitorModel       `tfsdk:"heartbeat_monitor"`
+	CronjobMonitor         *CronjobMonitorModel         `tfsdk:"cronjob_monitor"`
+	PingMonitor            *PingMonitorModel            `tfsdk:"ping_monitor"`
+	TcpMonitor             *TcpMonitorModel             `tfsdk:"tcp_monitor"`
+	DnsMonitor             *DnsMonitorModel             `tfsdk:"dns_monitor"`
+	CertificateMonitor     *CertificateMonitorModel     `tfsdk:"certificate_monitor"`
+	Email                  *EmailSettingsModel          `tfsdk:"email"`
 }
 
 type HttpMonitoringModel struct {
@@ -81,6 +99,46 @@ type HttpMonitoringModel struct {
 	IgnoreNonHttpErrors                types.Bool   `tfsdk:"ignore_non_http_errors"`
 }
 
+type HttpTransactionMonitorModel struct {
+	TimeoutInMilliseconds       types.Int64                `tfsdk:"timeout_in_milliseconds"`
+	MaxRetries                  types.Int64                `tfsdk:"max_retries"`
+	IntervalInSeconds           types.Int64                `tfsdk:"interval_in_seconds"`
+	AuthenticationType          types.String               `tfsdk:"authentication_type"`
+	BasicAuthenticationUsername types.String               `tfsdk:"basic_authentication_username"`
+	BasicAuthenticationPassword types.String               `tfsdk:"basic_authentication_password"`
+	BearerAuthenticationToken   types.String               `tfsdk:"bearer_authentication_token"`
+	SeverityDegraded            types.String               `tfsdk:"severity_degraded"`
+	SeverityDown                types.String               `tfsdk:"severity_down"`
+	IsPaused                    types.Bool                 `tfsdk:"is_paused"`
+	IgnoreNonHttpErrors         types.Bool                 `tfsdk:"ignore_non_http_errors"`
+	Steps                       []HttpTransactionStepModel `tfsdk:"steps"`
+}
+
+type HttpTransactionStepModel struct {
+	Name                types.String                     `tfsdk:"name"`
+	Method              types.String                     `tfsdk:"method"`
+	Url                 types.String                     `tfsdk:"url"`
+	Headers             types.Map                        `tfsdk:"headers"`
+	Body                types.String                     `tfsdk:"body"`
+	ExpectedStatusCodes types.List                       `tfsdk:"expected_status_codes"`
+	Extractors          *[]HttpTransactionExtractorModel `tfsdk:"extractors"`
+	Assertions          *[]HttpTransactionAssertionModel `tfsdk:"assertions"`
+}
+
+type HttpTransactionExtractorModel struct {
+	Variable types.String `tfsdk:"variable"`
+	JSONPath types.String `tfsdk:"json_path"`
+	Regex    types.String `tfsdk:"regex"`
+	Header   types.String `tfsdk:"header"`
+}
+
+type HttpTransactionAssertionModel struct {
+	Source     types.String `tfsdk:"source"`
+	Property   types.String `tfsdk:"property"`
+	Comparison types.String `tfsdk:"comparison"`
+	Value      types.String `tfsdk:"value"`
+}
+
 type EmailSettingsModel struct {
 	Aliases      types.List   `tfsdk:"aliases"`
 	EmailAddress types.String `tfsdk:"email_address"`
@@ -96,10 +154,51 @@ type PingMonitorModel struct {
 	IsPaused              types.Bool   `tfsdk:"is_paused"`
 }
 
+type TcpMonitorModel struct {
+	Host                  types.String `tfsdk:"host"`
+	Port                  types.Int64  `tfsdk:"port"`
+	TimeoutInMilliseconds types.Int64  `tfsdk:"timeout_in_milliseconds"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	IntervalInSeconds     types.Int64  `tfsdk:"interval_in_seconds"`
+	SeverityDegraded      types.String `tfsdk:"severity_degraded"`
+	SeverityDown          types.String `tfsdk:"severity_down"`
+	IsPaused              types.Bool   `tfsdk:"is_paused"`
+}
+
+type DnsMonitorModel struct {
+	Name                  types.String `tfsdk:"name"`
+	RecordType            types.String `tfsdk:"record_type"`
+	ExpectedValues        types.List   `tfsdk:"expected_values"`
+	Resolver              types.String `tfsdk:"resolver"`
+	TimeoutInMilliseconds types.Int64  `tfsdk:"timeout_in_milliseconds"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	IntervalInSeconds     types.Int64  `tfsdk:"interval_in_seconds"`
+	SeverityDegraded      types.String `tfsdk:"severity_degraded"`
+	SeverityDown          types.String `tfsdk:"severity_down"`
+	IsPaused              types.Bool   `tfsdk:"is_paused"`
+}
+
+type CertificateMonitorModel struct {
+	Host                     types.String `tfsdk:"host"`
+	Port                     types.Int64  `tfsdk:"port"`
+	Sni                      types.String `tfsdk:"sni"`
+	DaysBeforeExpiryDegraded types.Int64  `tfsdk:"days_before_expiry_degraded"`
+	DaysBeforeExpiryDown     types.Int64  `tfsdk:"days_before_expiry_down"`
+	TimeoutInMilliseconds    types.Int64  `tfsdk:"timeout_in_milliseconds"`
+	MaxRetries               types.Int64  `tfsdk:"max_retries"`
+	IntervalInSeconds        types.Int64  `tfsdk:"interval_in_seconds"`
+	SeverityDegraded         types.String `tfsdk:"severity_degraded"`
+	SeverityDown             types.String `tfsdk:"severity_down"`
+	IsPaused                 types.Bool   `tfsdk:"is_paused"`
+}
+
 type HeartbeatMonitorModel struct {
 	IntervalInSec    types.Int64  `tfsdk:"interval_in_sec"`
 	GracePeriodInSec types.Int64  `tfsdk:"grace_period_in_sec"`
 	Severity         types.String `tfsdk:"severity"`
+	PingUrl          types.String `tfsdk:"ping_url"`
+	PingUrlStart     types.String `tfsdk:"ping_url_start"`
+	PingUrlFail      types.String `tfsdk:"ping_url_fail"`
 }
 
 type CronjobMonitorModel struct {
@@ -107,17 +206,32 @@ type CronjobMonitorModel struct {
 	GracePeriodInSec types.Int64  `tfsdk:"grace_period_in_sec"`
 	Severity         types.String `tfsdk:"severity"`
 	TimeZoneId       types.String `tfsdk:"time_zone_id"`
+	NextRuns         types.List   `tfsdk:"next_runs"`
+	PingUrl          types.String `tfsdk:"ping_url"`
+	PingUrlStart     types.String `tfsdk:"ping_url_start"`
+	PingUrlFail      types.String `tfsdk:"ping_url_fail"`
 }
 
 type WebhookAuthenticationModel struct {
-	Type   types.String `tfsdk:"type"`
-	Bearer *BearerModel `tfsdk:"bearer"`
+	Type               types.String `tfsdk:"type"`
+	Bearer             *BearerModel `tfsdk:"bearer"`
+	Hmac               *HmacModel   `tfsdk:"hmac"`
+	AllowedSourceCidrs types.List   `tfsdk:"allowed_source_cidrs"`
 }
 
 type BearerModel struct {
 	Token types.String `tfsdk:"token"`
 }
 
+type HmacModel struct {
+	SignatureHeader             types.String `tfsdk:"signature_header"`
+	Algorithm                   types.String `tfsdk:"algorithm"`
+	Secret                      types.String `tfsdk:"secret"`
+	SecretVersion               types.Int64  `tfsdk:"secret_version"`
+	TimestampHeader             types.String `tfsdk:"timestamp_header"`
+	TimestampToleranceInSeconds types.Int64  `tfsdk:"timestamp_tolerance_in_seconds"`
+}
+
 type SnoozeSettingsModel struct {
 	SnoozeWindowInMinutes types.Int64          `tfsdk:"snooze_window_in_minutes"`
 	Filters               *[]SnoozeFilterModel `tfsdk:"filters"`
@@ -163,6 +277,21 @@ func (r *Integration) Schema(ctx context.Context, req resource.SchemaRequest, re
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"muted_until": schema.StringAttribute{
+				MarkdownDescription: "Mutes the integration until the given UTC date time, e.g. `2025-01-31T18:00:00Z`. The integration unmutes automatically afterwards and `is_muted` follows this attribute. Conflicts with `is_muted`.",
+				Optional:            true,
+				Validators: []validator.String{
+					DateTimeValidator("Not a valid date / time"),
+					stringvalidator.ConflictsWith(path.MatchRoot("is_muted")),
+				},
+			},
+			"muted_since": schema.StringAttribute{
+				MarkdownDescription: "Since when the integration is muted, as reported by All Quiet or otherwise when the provider first saw it muted. Plans warn about integrations muted without `muted_until` for longer than the provider's `mute_warning_threshold_in_days`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"is_in_maintenance": schema.BoolAttribute{
 				MarkdownDescription: "If the integration is in maintenance mode. Deprecated: Use resource `allquiet_integration_maintenance_window` instead.",
 				Optional:            true,
@@ -226,7 +355,7 @@ func (r *Integration) Schema(ctx context.Context, req resource.SchemaRequest, re
 				},
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of the integration. See all types here: https://allquiet.app/api/public/v1/inbound-integration/types",
+				MarkdownDescription: "The type of the integration. See all types here: https://allquiet.app/api/public/v1/inbound-integration/types or use the `allquiet_inbound_integration_types` data source",
 				Required:            true,
 			},
 			"labels": schema.ListAttribute{
@@ -237,14 +366,37 @@ func (r *Integration) Schema(ctx context.Context, req resource.SchemaRequest, re
 			"webhook_url": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "The webhook url of the integration if it is a webhook-like integration e.g. Amazon CloudWatch",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"webhook_url_rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "Any value, e.g. a date. Changing it regenerates `webhook_url` in place, keeping the integration and everything referencing it. " +
+					"Setting it for the first time does not rotate the url.",
+				Optional: true,
+			},
+			"webhook_url_rotation_grace_period_in_minutes": schema.Int64Attribute{
+				MarkdownDescription: "How long the previous webhook url keeps working after a rotation, at most one week. If not set, the previous url stops working immediately.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 10080),
+					int64validator.AlsoRequires(path.MatchRoot("webhook_url_rotation_trigger")),
+				},
+			},
+			"previous_webhook_url_valid_until": schema.StringAttribute{
+				MarkdownDescription: "Until when the previous webhook url keeps working after a rotation with a grace period",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"webhook_authentication": schema.SingleNestedAttribute{
 				MarkdownDescription: "The webhook authentication of the integration",
 				Optional:            true,
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
-						MarkdownDescription: "The type of the webhook authentication. Possible values are: " + strings.Join(ValidWebhookAuthenticationTypes, ", "),
-						Required:            true,
+						MarkdownDescription: "The type of the webhook authentication. Possible values are: " + strings.Join(ValidWebhookAuthenticationTypes, ", ") + ". If not set, webhooks are not authenticated and only restricted by `allowed_source_cidrs`.",
+						Optional:            true,
 						Validators:          []validator.String{WebhookAuthenticationTypeValidator("Not a valid webhook authentication type")},
 					},
 					"bearer": schema.SingleNestedAttribute{
@@ -258,6 +410,61 @@ func (r *Integration) Schema(ctx context.Context, req resource.SchemaRequest, re
 							},
 						},
 					},
+					"hmac": schema.SingleNestedAttribute{
+						MarkdownDescription: "The HMAC signature settings of the webhook authentication. Senders sign the payload with the secret and send the hex encoded signature, " +
+							"optionally prefixed with `<algorithm>=`, in the signature header. If a timestamp header is set, `<timestamp>.<payload>` is signed instead. " +
+							"Use the `webhook_signature` function to compute the expected signature.",
+						Optional: true,
+						Attributes: map[string]schema.Attribute{
+							"signature_header": schema.StringAttribute{
+								MarkdownDescription: "The header the signature is sent in, e.g. `X-Hub-Signature-256`",
+								Required:            true,
+								Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+							},
+							"algorithm": schema.StringAttribute{
+								MarkdownDescription: "The hash algorithm of the signature. Possible values are: " + strings.Join(ValidWebhookHmacAlgorithms, ", ") + ". Defaults to sha256.",
+								Optional:            true,
+								Computed:            true,
+								Default:             stringdefault.StaticString("sha256"),
+								Validators:          []validator.String{WebhookHmacAlgorithmValidator("Not a valid hmac algorithm")},
+							},
+							"secret": schema.StringAttribute{
+								MarkdownDescription: "The secret the payload is signed with. It is write-only and never stored in the state. Requires Terraform 1.11 or later.",
+								Required:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
+							},
+							"secret_version": schema.Int64Attribute{
+								MarkdownDescription: "Changing only the write-only `secret` does not update the integration, as write-only values are not part of the plan. The secret is sent to All Quiet with every create and update, so change the version to send a new secret right away.",
+								Optional:            true,
+							},
+							"timestamp_header": schema.StringAttribute{
+								MarkdownDescription: "The header the unix timestamp of the signature is sent in, e.g. `X-Timestamp`. If set, requests with a timestamp outside of the tolerance are rejected.",
+								Optional:            true,
+								Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+							},
+							"timestamp_tolerance_in_seconds": schema.Int64Attribute{
+								MarkdownDescription: "The maximum age of the timestamp in seconds. Only applies with timestamp_header. Defaults to 300.",
+								Optional:            true,
+								Computed:            true,
+								Default:             int64default.StaticInt64(300),
+								Validators: []validator.Int64{
+									int64validator.Between(1, 3600),
+									int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("timestamp_header")),
+								},
+							},
+						},
+					},
+					"allowed_source_cidrs": schema.ListAttribute{
+						MarkdownDescription: "The CIDRs webhooks are accepted from, e.g. `192.0.2.0/24`. If not set, webhooks are accepted from any source.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(CIDRValidator("Not a valid CIDR")),
+						},
+					},
 				},
 			},
 			"integration_settings": schema.SingleNestedAttribute{
@@ -383,6 +590,192 @@ func (r *Integration) Schema(ctx context.Context, req resource.SchemaRequest, re
 							},
 						},
 					},
+					"http_transaction_monitor": schema.SingleNestedAttribute{
+						MarkdownDescription: "The http transaction monitor of the integration. Runs a chain of http requests, e.g. a login flow, where values extracted from a response can be used in the following steps as `{{variable}}`",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"timeout_in_milliseconds": schema.Int64Attribute{
+								MarkdownDescription: "The timeout in milliseconds of each step of the http transaction monitor. Valid values are: " + strings.Join(convertInt64ArrayToStringArray(ValidTimeoutsHttpMonitoringInMilliseconds), ", "),
+								Required:            true,
+								Validators: []validator.Int64{
+									ValidTimeoutsHttpMonitoringInMillisecondsValidator("Not a valid timeout in milliseconds"),
+								},
+							},
+							"max_retries": schema.Int64Attribute{
+								MarkdownDescription: "The max retries of the http transaction monitor",
+								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.Between(0, 5),
+								},
+								Computed: true,
+							},
+							"interval_in_seconds": schema.Int64Attribute{
+								MarkdownDescription: "The interval in seconds of the http transaction monitor. Valid values are: " + strings.Join(ValidIntervalsInSecondsAsString, ", "),
+								Required:            true,
+								Validators: []validator.Int64{
+									IntervalInSecondsValidator("Not a valid interval in seconds"),
+								},
+							},
+							"authentication_type": schema.StringAttribute{
+								MarkdownDescription: "The authentication type used for every step of the http transaction monitor. Possible values are: " + strings.Join(ValidHttpMonitoringAuthenticationTypes, ", "),
+								Optional:            true,
+								Validators:          []validator.String{HttpMonitoringAuthenticationTypeValidator("Not a valid authentication type")},
+							},
+							"basic_authentication_username": schema.StringAttribute{
+								MarkdownDescription: "The basic authentication username of the http transaction monitor",
+								Optional:            true,
+								Sensitive:           true,
+							},
+							"basic_authentication_password": schema.StringAttribute{
+								MarkdownDescription: "The basic authentication password of the http transaction monitor",
+								Optional:            true,
+								Sensitive:           true,
+							},
+							"bearer_authentication_token": schema.StringAttribute{
+								MarkdownDescription: "The bearer authentication token of the http transaction monitor",
+								Optional:            true,
+								Sensitive:           true,
+							},
+							"is_paused": schema.BoolAttribute{
+								MarkdownDescription: "If the http transaction monitor is paused",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"severity_degraded": schema.StringAttribute{
+								MarkdownDescription: "The severity degraded of the http transaction monitor. Possible values are: " + strings.Join(ValidSeverities, ", "),
+								Optional:            true,
+								Validators: []validator.String{
+									SeverityValidator("Not a valid severity"),
+								},
+							},
+							"severity_down": schema.StringAttribute{
+								MarkdownDescription: "The severity down of the http transaction monitor. Possible values are: " + strings.Join(ValidSeverities, ", "),
+								Optional:            true,
+								Validators: []validator.String{
+									SeverityValidator("Not a valid severity"),
+								},
+							},
+							"ignore_non_http_errors": schema.BoolAttribute{
+								MarkdownDescription: "When true, connection and transport failures (non-HTTP errors) are ignored and do not trigger incidents",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"steps": schema.ListNestedAttribute{
+								MarkdownDescription: "The steps of the http transaction monitor, run in the order they are defined. The transaction stops at the first failing step.",
+								Required:            true,
+								Validators: []validator.List{
+									listvalidator.SizeBetween(1, 10),
+								},
+								NestedObject: schema.NestedAttributeObject{
+									Attributes: map[string]schema.Attribute{
+										"name": schema.StringAttribute{
+											MarkdownDescription: "The name of the step. Must be unique within the transaction",
+											Required:            true,
+										},
+										"method": schema.StringAttribute{
+											MarkdownDescription: "The method of the step. Possible values are: " + strings.Join(ValidHttpMonitoringMethods, ", "),
+											Required:            true,
+											Validators: []validator.String{
+												HttpMonitoringMethodValidator("Not a valid method"),
+											},
+										},
+										"url": schema.StringAttribute{
+											MarkdownDescription: "The url of the step. May reference variables of previous steps",
+											Required:            true,
+										},
+										"headers": schema.MapAttribute{
+											MarkdownDescription: "The headers of the step. Values may reference variables of previous steps",
+											Optional:            true,
+											Sensitive:           true,
+											ElementType:         types.StringType,
+										},
+										"body": schema.StringAttribute{
+											MarkdownDescription: "The body to send in the request of the step. May reference variables of previous steps",
+											Optional:            true,
+											Sensitive:           true,
+										},
+										"expected_status_codes": schema.ListAttribute{
+											MarkdownDescription: "Optional. If empty, 2xx status codes are accepted. If specified, only the specified HTTP status codes pass the step. Each value must be between 100 and 599.",
+											Optional:            true,
+											ElementType:         types.Int64Type,
+											Validators: []validator.List{
+												listvalidator.ValueInt64sAre(ValidHttpStatusCodesValidator("Each status code must be between 100 and 599")),
+											},
+										},
+										"extractors": schema.ListNestedAttribute{
+											MarkdownDescription: "The values to extract from the response into variables for the following steps",
+											Optional:            true,
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"variable": schema.StringAttribute{
+														MarkdownDescription: "The name of the variable, referenced as `{{variable}}` in the following steps",
+														Required:            true,
+														Validators: []validator.String{
+															HttpTransactionVariableValidator("Must start with a letter or underscore and contain only letters, digits and underscores"),
+														},
+													},
+													"json_path": schema.StringAttribute{
+														MarkdownDescription: "The JSONPath expression to extract from the response body",
+														Optional:            true,
+														Validators: []validator.String{
+															JSONPathValidator("Not a valid JSONPath expression"),
+															stringvalidator.ExactlyOneOf(
+																path.MatchRelative().AtParent().AtName("regex"),
+																path.MatchRelative().AtParent().AtName("header"),
+															),
+														},
+													},
+													"regex": schema.StringAttribute{
+														MarkdownDescription: "The regular expression to extract from the response body. The named group `result` is extracted, the last group if there is none, or the whole match if there are no groups",
+														Optional:            true,
+														Validators: []validator.String{
+															MappingRegexValidator("Not a valid regular expression"),
+														},
+													},
+													"header": schema.StringAttribute{
+														MarkdownDescription: "The name of the response header to extract",
+														Optional:            true,
+													},
+												},
+											},
+										},
+										"assertions": schema.ListNestedAttribute{
+											MarkdownDescription: "The assertions on the response of the step, in addition to `expected_status_codes`",
+											Optional:            true,
+											NestedObject: schema.NestedAttributeObject{
+												Attributes: map[string]schema.Attribute{
+													"source": schema.StringAttribute{
+														MarkdownDescription: "The part of the response to assert on. Possible values are: " + strings.Join(ValidHttpTransactionAssertionSources, ", "),
+														Required:            true,
+														Validators: []validator.String{
+															HttpTransactionAssertionSourceValidator("Not a valid assertion source"),
+														},
+													},
+													"property": schema.StringAttribute{
+														MarkdownDescription: "The JSONPath expression for the source `JsonPath` or the header name for the source `Header`",
+														Optional:            true,
+													},
+													"comparison": schema.StringAttribute{
+														MarkdownDescription: "The comparison of the assertion. Possible values are: " + strings.Join(ValidHttpTransactionAssertionComparisons, ", "),
+														Required:            true,
+														Validators: []validator.String{
+															HttpTransactionAssertionComparisonValidator("Not a valid assertion comparison"),
+														},
+													},
+													"value": schema.StringAttribute{
+														MarkdownDescription: "The value to compare with. May reference variables of previous steps",
+														Required:            true,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
 					"heartbeat_monitor": schema.SingleNestedAttribute{
 						MarkdownDescription: "The heartbeat monitor of the integration",
 						Optional:            true,
@@ -402,6 +795,27 @@ func (r *Integration) Schema(ctx context.Context, req resource.SchemaRequest, re
 									SeverityValidator("Not a valid severity"),
 								},
 							},
+							"ping_url": schema.StringAttribute{
+								MarkdownDescription: "The url the job pings when it succeeded. Use the `heartbeat_wrapper` function to generate a wrapper script for the job",
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"ping_url_start": schema.StringAttribute{
+								MarkdownDescription: "The url the job pings when it starts, so that its runtime is tracked",
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"ping_url_fail": schema.StringAttribute{
+								MarkdownDescription: "The url the job pings when it failed, so that the heartbeat monitor is down immediately instead of after the grace period",
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
 						},
 					},
 					"cronjob_monitor": schema.SingleNestedAttribute{
@@ -409,8 +823,11 @@ func (r *Integration) Schema(ctx context.Context, req resource.SchemaRequest, re
 						Optional:            true,
 						Attributes: map[string]schema.Attribute{
 							"cron_expression": schema.StringAttribute{
-								MarkdownDescription: "The cron expression of the cronjob monitor",
+								MarkdownDescription: "The cron expression of the cronjob monitor, with five fields (minute, hour, day of month, month, day of week) or six fields with leading seconds",
 								Required:            true,
+								Validators: []validator.String{
+									CronExpressionValidator("Not a valid cron expression"),
+								},
 							},
 							"grace_period_in_sec": schema.Int64Attribute{
 								MarkdownDescription: "The grace period in seconds of the cronjob monitor",
@@ -424,8 +841,35 @@ func (r *Integration) Schema(ctx context.Context, req resource.SchemaRequest, re
 								},
 							},
 							"time_zone_id": schema.StringAttribute{
-								MarkdownDescription: "The time zone id of the cronjob monitor. Find all timezone ids [here](https://allquiet.app/api/public/v1/timezone)",
+								MarkdownDescription: "The time zone id of the cronjob monitor. Find all timezone ids [here](https://allquiet.app/api/public/v1/timezone) or use the `allquiet_time_zones` data source",
 								Optional:            true,
+								Validators:          []validator.String{TimeZoneValidator("Not a valid time zone id")},
+							},
+							"next_runs": schema.ListAttribute{
+								MarkdownDescription: "The next " + strconv.Itoa(cronNextRunsCount) + " expected runs of the cronjob as RFC 3339 timestamps in the time zone of the cronjob monitor, UTC if `time_zone_id` is not set. Updated on every refresh",
+								Computed:            true,
+								ElementType:         types.StringType,
+							},
+							"ping_url": schema.StringAttribute{
+								MarkdownDescription: "The url the job pings when it succeeded. Use the `heartbeat_wrapper` function to generate a wrapper script for the job",
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"ping_url_start": schema.StringAttribute{
+								MarkdownDescription: "The url the job pings when it starts, so that its runtime is tracked",
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"ping_url_fail": schema.StringAttribute{
+								MarkdownDescription: "The url the job pings when it failed, so that the cronjob monitor is down immediately instead of after the grace period",
+								Computed:            true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
 							},
 						},
 					},
@@ -481,6 +925,215 @@ func (r *Integration) Schema(ctx context.Context, req resource.SchemaRequest, re
 							},
 						},
 					},
+					"tcp_monitor": schema.SingleNestedAttribute{
+						MarkdownDescription: "The TCP monitor of the integration. Checks that a connection to the port can be established, e.g. for databases or message brokers",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"host": schema.StringAttribute{
+								MarkdownDescription: "The host of the TCP monitor",
+								Required:            true,
+							},
+							"port": schema.Int64Attribute{
+								MarkdownDescription: "The port of the TCP monitor",
+								Required:            true,
+								Validators: []validator.Int64{
+									PortValidator("Not a valid port"),
+								},
+							},
+							"timeout_in_milliseconds": schema.Int64Attribute{
+								MarkdownDescription: "The timeout in milliseconds of the TCP monitor. Valid values are: " + strings.Join(convertInt64ArrayToStringArray(ValidTimeoutsTcpMonitorInMilliseconds), ", "),
+								Required:            true,
+								Validators: []validator.Int64{
+									ValidTimeoutsTcpMonitorInMillisecondsValidator("Not a valid timeout in milliseconds"),
+								},
+							},
+							"max_retries": schema.Int64Attribute{
+								MarkdownDescription: "The max retries of the TCP monitor",
+								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.Between(0, 5),
+								},
+								Computed: true,
+							},
+							"interval_in_seconds": schema.Int64Attribute{
+								MarkdownDescription: "The interval in seconds of the TCP monitor. Valid values are: " + strings.Join(ValidIntervalsInSecondsAsString, ", "),
+								Required:            true,
+								Validators: []validator.Int64{
+									IntervalInSecondsValidator("Not a valid interval in seconds"),
+								},
+							},
+							"is_paused": schema.BoolAttribute{
+								MarkdownDescription: "If the TCP monitor is paused",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"severity_degraded": schema.StringAttribute{
+								MarkdownDescription: "The severity degraded of the TCP monitor. Possible values are: " + strings.Join(ValidSeverities, ", "),
+								Optional:            true,
+								Validators: []validator.String{
+									SeverityValidator("Not a valid severity"),
+								},
+							},
+							"severity_down": schema.StringAttribute{
+								MarkdownDescription: "The severity down of the TCP monitor. Possible values are: " + strings.Join(ValidSeverities, ", "),
+								Optional:            true,
+								Validators: []validator.String{
+									SeverityValidator("Not a valid severity"),
+								},
+							},
+						},
+					},
+					"dns_monitor": schema.SingleNestedAttribute{
+						MarkdownDescription: "The DNS monitor of the integration. Checks that a DNS record resolves and, if `expected_values` are set, that it resolves to these values",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The domain name to resolve, e.g. `allquiet.app`",
+								Required:            true,
+							},
+							"record_type": schema.StringAttribute{
+								MarkdownDescription: "The record type to resolve. Possible values are: " + strings.Join(ValidDnsRecordTypes, ", "),
+								Required:            true,
+								Validators: []validator.String{
+									DnsRecordTypeValidator("Not a valid DNS record type"),
+								},
+							},
+							"expected_values": schema.ListAttribute{
+								MarkdownDescription: "The values the record is expected to resolve to, in any order. If not set, any value is accepted",
+								ElementType:         types.StringType,
+								Optional:            true,
+								Validators: []validator.List{
+									listvalidator.SizeAtLeast(1),
+								},
+							},
+							"resolver": schema.StringAttribute{
+								MarkdownDescription: "The DNS resolver to query, as IP address with an optional port, e.g. `1.1.1.1` or `8.8.8.8:53`. If not set, the default resolver of All Quiet is used",
+								Optional:            true,
+							},
+							"timeout_in_milliseconds": schema.Int64Attribute{
+								MarkdownDescription: "The timeout in milliseconds of the DNS monitor. Valid values are: " + strings.Join(convertInt64ArrayToStringArray(ValidTimeoutsDnsMonitorInMilliseconds), ", "),
+								Required:            true,
+								Validators: []validator.Int64{
+									ValidTimeoutsDnsMonitorInMillisecondsValidator("Not a valid timeout in milliseconds"),
+								},
+							},
+							"max_retries": schema.Int64Attribute{
+								MarkdownDescription: "The max retries of the DNS monitor",
+								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.Between(0, 5),
+								},
+								Computed: true,
+							},
+							"interval_in_seconds": schema.Int64Attribute{
+								MarkdownDescription: "The interval in seconds of the DNS monitor. Valid values are: " + strings.Join(ValidIntervalsInSecondsAsString, ", "),
+								Required:            true,
+								Validators: []validator.Int64{
+									IntervalInSecondsValidator("Not a valid interval in seconds"),
+								},
+							},
+							"is_paused": schema.BoolAttribute{
+								MarkdownDescription: "If the DNS monitor is paused",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"severity_degraded": schema.StringAttribute{
+								MarkdownDescription: "The severity degraded of the DNS monitor, used if the record resolves to other values than `expected_values`. Possible values are: " + strings.Join(ValidSeverities, ", "),
+								Optional:            true,
+								Validators: []validator.String{
+									SeverityValidator("Not a valid severity"),
+								},
+							},
+							"severity_down": schema.StringAttribute{
+								MarkdownDescription: "The severity down of the DNS monitor, used if the record does not resolve. Possible values are: " + strings.Join(ValidSeverities, ", "),
+								Optional:            true,
+								Validators: []validator.String{
+									SeverityValidator("Not a valid severity"),
+								},
+							},
+						},
+					},
+					"certificate_monitor": schema.SingleNestedAttribute{
+						MarkdownDescription: "The certificate monitor of the integration. Checks the expiry of the TLS certificate of any TLS endpoint, e.g. mail servers or databases",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"host": schema.StringAttribute{
+								MarkdownDescription: "The host of the certificate monitor",
+								Required:            true,
+							},
+							"port": schema.Int64Attribute{
+								MarkdownDescription: "The port of the certificate monitor. Defaults to 443",
+								Optional:            true,
+								Computed:            true,
+								Default:             int64default.StaticInt64(443),
+								Validators: []validator.Int64{
+									PortValidator("Not a valid port"),
+								},
+							},
+							"sni": schema.StringAttribute{
+								MarkdownDescription: "The server name sent in the TLS handshake. If not set, the host is used",
+								Optional:            true,
+							},
+							"days_before_expiry_degraded": schema.Int64Attribute{
+								MarkdownDescription: "The number of days before the certificate expires from which the certificate monitor is degraded",
+								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"days_before_expiry_down": schema.Int64Attribute{
+								MarkdownDescription: "The number of days before the certificate expires from which the certificate monitor is down. Must be less than `days_before_expiry_degraded`",
+								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
+							},
+							"timeout_in_milliseconds": schema.Int64Attribute{
+								MarkdownDescription: "The timeout in milliseconds of the certificate monitor. Valid values are: " + strings.Join(convertInt64ArrayToStringArray(ValidTimeoutsCertificateMonitorInMilliseconds), ", "),
+								Required:            true,
+								Validators: []validator.Int64{
+									ValidTimeoutsCertificateMonitorInMillisecondsValidator("Not a valid timeout in milliseconds"),
+								},
+							},
+							"max_retries": schema.Int64Attribute{
+								MarkdownDescription: "The max retries of the certificate monitor",
+								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.Between(0, 5),
+								},
+								Computed: true,
+							},
+							"interval_in_seconds": schema.Int64Attribute{
+								MarkdownDescription: "The interval in seconds of the certificate monitor. Valid values are: " + strings.Join(ValidIntervalsInSecondsAsString, ", "),
+								Required:            true,
+								Validators: []validator.Int64{
+									IntervalInSecondsValidator("Not a valid interval in seconds"),
+								},
+							},
+							"is_paused": schema.BoolAttribute{
+								MarkdownDescription: "If the certificate monitor is paused",
+								Optional:            true,
+								Computed:            true,
+								Default:             booldefault.StaticBool(false),
+							},
+							"severity_degraded": schema.StringAttribute{
+								MarkdownDescription: "The severity degraded of the certificate monitor. Possible values are: " + strings.Join(ValidSeverities, ", "),
+								Optional:            true,
+								Validators: []validator.String{
+									SeverityValidator("Not a valid severity"),
+								},
+							},
+							"severity_down": schema.StringAttribute{
+								MarkdownDescription: "The severity down of the certificate monitor. Possible values are: " + strings.Join(ValidSeverities, ", "),
+								Optional:            true,
+								Validators: []validator.String{
+									SeverityValidator("Not a valid severity"),
+								},
+							},
+						},
+					},
 					"email": schema.SingleNestedAttribute{
 						MarkdownDescription: "The email settings of the integration",
 						Optional:            true,
@@ -502,6 +1155,373 @@ func (r *Integration) Schema(ctx context.Context, req resource.SchemaRequest, re
 	}
 }
 
+func (r *Integration) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var mutedUntil types.String
+	var webhookAuthentication types.Object
+	var integrationSettings types.Object
+
+	// The nested objects are read one by one, as any of them may still be unknown.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("muted_until"), &mutedUntil)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("webhook_authentication"), &webhookAuthentication)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("integration_settings"), &integrationSettings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !mutedUntil.IsNull() && !mutedUntil.IsUnknown() {
+		mutedUntilTime, err := time.Parse(time.RFC3339, mutedUntil.ValueString())
+		if err == nil && mutedUntilTime.Before(time.Now()) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("muted_until"),
+				"Mute Expired",
+				fmt.Sprintf("muted_until (%s) is in the past, so the integration is not muted. Remove muted_until to clean up the configuration.", mutedUntil.ValueString()),
+			)
+		}
+	}
+
+	var authentication WebhookAuthenticationModel
+	if asKnownObject(ctx, webhookAuthentication, &authentication) {
+		resp.Diagnostics.Append(validateWebhookAuthentication(&authentication, path.Root("webhook_authentication"))...)
+	}
+
+	if integrationSettings.IsNull() || integrationSettings.IsUnknown() {
+		return
+	}
+
+	settingsPath := path.Root("integration_settings")
+	monitors := map[string]types.Object{}
+	for _, name := range []string{"cronjob_monitor", "certificate_monitor", "http_transaction_monitor"} {
+		var monitor types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, settingsPath.AtName(name), &monitor)...)
+		monitors[name] = monitor
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var cronjobMonitor CronjobMonitorModel
+	if asKnownObject(ctx, monitors["cronjob_monitor"], &cronjobMonitor) {
+		resp.Diagnostics.Append(validateCronjobMonitor(&cronjobMonitor, settingsPath.AtName("cronjob_monitor"))...)
+	}
+
+	var certificateMonitor CertificateMonitorModel
+	if asKnownObject(ctx, monitors["certificate_monitor"], &certificateMonitor) {
+		resp.Diagnostics.Append(validateCertificateMonitor(&certificateMonitor, settingsPath.AtName("certificate_monitor"))...)
+	}
+
+	var httpTransactionMonitor HttpTransactionMonitorModel
+	if asKnownObject(ctx, monitors["http_transaction_monitor"], &httpTransactionMonitor) {
+		resp.Diagnostics.Append(validateHttpTransactionSteps(ctx, httpTransactionMonitor.Steps, settingsPath.AtName("http_transaction_monitor").AtName("steps"))...)
+	}
+}
+
+func (r *Integration) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	// Only the attributes planned here are read, as nested objects of the plan may still be unknown.
+	var rotationTrigger types.String
+	var mutedUntil types.String
+	var isMuted types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("webhook_url_rotation_trigger"), &rotationTrigger)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("muted_until"), &mutedUntil)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("is_muted"), &isMuted)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state IntegrationModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if isWebhookUrlRotation(state.WebhookUrlRotationTrigger, rotationTrigger) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("webhook_url"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("previous_webhook_url_valid_until"), types.StringUnknown())...)
+
+			// The ping urls of heartbeat and cronjob monitors are rotated along with the webhook url.
+			for _, monitor := range []string{"heartbeat_monitor", "cronjob_monitor"} {
+				monitorPath := path.Root("integration_settings").AtName(monitor)
+
+				var monitorObject types.Object
+				resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, monitorPath, &monitorObject)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				if monitorObject.IsNull() || monitorObject.IsUnknown() {
+					continue
+				}
+
+				for _, name := range []string{"ping_url", "ping_url_start", "ping_url_fail"} {
+					resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, monitorPath.AtName(name), types.StringUnknown())...)
+				}
+			}
+		}
+	}
+
+	// With muted_until, the integration is muted as long as muted_until is in the future.
+	plannedIsMuted := isMuted
+	if !mutedUntil.IsNull() {
+		plannedIsMuted = types.BoolUnknown()
+		if !mutedUntil.IsUnknown() {
+			mutedUntilTime, err := time.Parse(time.RFC3339, mutedUntil.ValueString())
+			if err == nil {
+				plannedIsMuted = types.BoolValue(mutedUntilTime.After(time.Now()))
+			}
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("is_muted"), plannedIsMuted)...)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	// muted_since is kept from the state while the integration stays muted.
+	switch {
+	case plannedIsMuted.IsUnknown() || (plannedIsMuted.ValueBool() && (!state.IsMuted.ValueBool() || state.MutedSince.IsNull())):
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("muted_since"), types.StringUnknown())...)
+	case !plannedIsMuted.ValueBool():
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("muted_since"), types.StringNull())...)
+	}
+
+	if r.client == nil || !mutedUntil.IsNull() || !isMuted.ValueBool() {
+		return
+	}
+
+	if !state.IsMuted.ValueBool() || state.MutedSince.IsNull() || state.MutedSince.IsUnknown() {
+		return
+	}
+
+	mutedSince, err := time.Parse(time.RFC3339, state.MutedSince.ValueString())
+	if err != nil {
+		return
+	}
+
+	mutedFor := time.Since(mutedSince)
+	threshold := time.Duration(r.client.MuteWarningThresholdInDays) * 24 * time.Hour
+	if mutedFor > threshold {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("is_muted"),
+			"Integration Muted For Too Long",
+			fmt.Sprintf("Integration %q has been muted since %s (%d days), longer than the threshold of %d days. Unmute it or set muted_until so it unmutes automatically.",
+				state.DisplayName.ValueString(), state.MutedSince.ValueString(), int64(mutedFor.Hours()/24), r.client.MuteWarningThresholdInDays),
+		)
+	}
+}
+
+// isWebhookUrlRotation reports whether the webhook url has to be rotated because the rotation trigger changed.
+// Setting the trigger for the first time does not rotate, so it can be added to existing integrations.
+func isWebhookUrlRotation(prior types.String, planned types.String) bool {
+	if prior.IsNull() || planned.IsNull() {
+		return false
+	}
+	return !prior.Equal(planned)
+}
+
+// validateCronjobMonitor warns if the grace period is longer than the time between two runs, as a missed
+// run would then only be detected after the next run was due.
+func validateCronjobMonitor(cronjobMonitor *CronjobMonitorModel, monitorPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if cronjobMonitor.CronExpression.IsNull() || cronjobMonitor.CronExpression.IsUnknown() ||
+		cronjobMonitor.GracePeriodInSec.IsNull() || cronjobMonitor.GracePeriodInSec.IsUnknown() || cronjobMonitor.TimeZoneId.IsUnknown() {
+		return diags
+	}
+
+	schedule, err := validators.ParseCronExpression(cronjobMonitor.CronExpression.ValueString())
+	if err != nil {
+		return diags
+	}
+
+	location, err := loadCronLocation(cronjobMonitor.TimeZoneId.ValueStringPointer())
+	if err != nil {
+		return diags
+	}
+
+	interval, ok := schedule.MinInterval(time.Now().In(location), 100)
+	gracePeriod := time.Duration(cronjobMonitor.GracePeriodInSec.ValueInt64()) * time.Second
+	if ok && gracePeriod > interval {
+		diags.AddAttributeWarning(
+			monitorPath.AtName("grace_period_in_sec"),
+			"Grace Period Exceeds Interval",
+			fmt.Sprintf("grace_period_in_sec (%d) is longer than the %s between runs of %q, so a missed run is only detected after the next run was due.", cronjobMonitor.GracePeriodInSec.ValueInt64(), interval, cronjobMonitor.CronExpression.ValueString()),
+		)
+	}
+
+	return diags
+}
+
+// validateWebhookAuthentication checks that exactly the settings block of the authentication type is set, and
+// none without a type.
+func validateWebhookAuthentication(authentication *WebhookAuthenticationModel, authenticationPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if authentication.Type.IsUnknown() {
+		return diags
+	}
+
+	authenticationType := authentication.Type.ValueString()
+	blocks := map[string]bool{
+		"bearer": authentication.Bearer != nil,
+		"hmac":   authentication.Hmac != nil,
+	}
+
+	for _, name := range ValidWebhookAuthenticationTypes {
+		if name == authenticationType && !blocks[name] {
+			diags.AddAttributeError(
+				authenticationPath.AtName(name),
+				"Missing Required Attribute",
+				fmt.Sprintf("When the webhook authentication type is '%s', %s must be specified", authenticationType, name),
+			)
+		}
+		if name != authenticationType && blocks[name] {
+			diags.AddAttributeError(
+				authenticationPath.AtName(name),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s can only be specified when the webhook authentication type is '%s'", name, name),
+			)
+		}
+	}
+
+	return diags
+}
+
+func validateCertificateMonitor(certificateMonitor *CertificateMonitorModel, monitorPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if certificateMonitor.DaysBeforeExpiryDegraded.IsNull() || certificateMonitor.DaysBeforeExpiryDegraded.IsUnknown() ||
+		certificateMonitor.DaysBeforeExpiryDown.IsNull() || certificateMonitor.DaysBeforeExpiryDown.IsUnknown() {
+		return diags
+	}
+
+	if certificateMonitor.DaysBeforeExpiryDown.ValueInt64() >= certificateMonitor.DaysBeforeExpiryDegraded.ValueInt64() {
+		diags.AddAttributeError(
+			monitorPath.AtName("days_before_expiry_down"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("days_before_expiry_down (%d) must be less than days_before_expiry_degraded (%d)", certificateMonitor.DaysBeforeExpiryDown.ValueInt64(), certificateMonitor.DaysBeforeExpiryDegraded.ValueInt64()),
+		)
+	}
+
+	return diags
+}
+
+// httpTransactionVariableReference matches references like {{token}} to variables extracted by previous steps.
+var httpTransactionVariableReference = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// validateHttpTransactionSteps checks that step names and variables are unique, that steps only reference
+// variables extracted by previous steps and that assertions are complete.
+func validateHttpTransactionSteps(ctx context.Context, steps []HttpTransactionStepModel, stepsPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	stepNames := map[string]bool{}
+	variables := map[string]bool{}
+	// Once a variable is unknown, any undefined reference may be that variable.
+	hasUnknownVariable := false
+
+	for i, step := range steps {
+		stepPath := stepsPath.AtListIndex(i)
+
+		if !step.Name.IsUnknown() {
+			if stepNames[step.Name.ValueString()] {
+				diags.AddAttributeError(stepPath.AtName("name"), "Duplicate Step Name", fmt.Sprintf("Step name %q is used more than once", step.Name.ValueString()))
+			}
+			stepNames[step.Name.ValueString()] = true
+		}
+
+		checkReferences := func(valuePath path.Path, value types.String) {
+			if value.IsNull() || value.IsUnknown() || hasUnknownVariable {
+				return
+			}
+
+			for _, match := range httpTransactionVariableReference.FindAllStringSubmatch(value.ValueString(), -1) {
+				if !variables[match[1]] {
+					diags.AddAttributeError(
+						valuePath,
+						"Undefined Variable",
+						fmt.Sprintf("Step %q references variable %q, which is not extracted by a previous step", step.Name.ValueString(), match[1]),
+					)
+				}
+			}
+		}
+
+		checkReferences(stepPath.AtName("url"), step.Url)
+		checkReferences(stepPath.AtName("body"), step.Body)
+		for name, value := range step.Headers.Elements() {
+			if value, ok := value.(types.String); ok {
+				checkReferences(stepPath.AtName("headers").AtMapKey(name), value)
+			}
+		}
+
+		if step.Assertions != nil {
+			for j, assertion := range *step.Assertions {
+				assertionPath := stepPath.AtName("assertions").AtListIndex(j)
+				checkReferences(assertionPath.AtName("value"), assertion.Value)
+				diags.Append(validateHttpTransactionAssertion(ctx, assertion, assertionPath)...)
+			}
+		}
+
+		// Variables are only available to the steps after the one extracting them.
+		if step.Extractors != nil {
+			for j, extractor := range *step.Extractors {
+				if extractor.Variable.IsUnknown() {
+					hasUnknownVariable = true
+					continue
+				}
+
+				if variables[extractor.Variable.ValueString()] {
+					diags.AddAttributeError(
+						stepPath.AtName("extractors").AtListIndex(j).AtName("variable"),
+						"Duplicate Variable",
+						fmt.Sprintf("Variable %q is extracted more than once", extractor.Variable.ValueString()),
+					)
+				}
+				variables[extractor.Variable.ValueString()] = true
+			}
+		}
+	}
+
+	return diags
+}
+
+func validateHttpTransactionAssertion(ctx context.Context, assertion HttpTransactionAssertionModel, assertionPath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if assertion.Source.IsUnknown() || assertion.Comparison.IsUnknown() {
+		return diags
+	}
+
+	source := assertion.Source.ValueString()
+	if (source == "JsonPath" || source == "Header") && assertion.Property.IsNull() {
+		diags.AddAttributeError(assertionPath.AtName("property"), "Missing Required Attribute", fmt.Sprintf("property is required for assertions on %s", source))
+	}
+
+	if source == "JsonPath" {
+		diags.Append(validateStringValue(ctx, JSONPathValidator("Not a valid JSONPath expression"), assertion.Property, assertionPath.AtName("property"))...)
+	}
+
+	comparison := assertion.Comparison.ValueString()
+	if assertion.Value.IsNull() || assertion.Value.IsUnknown() || httpTransactionVariableReference.MatchString(assertion.Value.ValueString()) {
+		return diags
+	}
+
+	switch comparison {
+	case "LessThan", "GreaterThan":
+		_, err := strconv.ParseFloat(assertion.Value.ValueString(), 64)
+		if err != nil {
+			diags.AddAttributeError(assertionPath.AtName("value"), "Invalid Attribute Value", fmt.Sprintf("value must be a number for the comparison %s", comparison))
+		}
+	case "Matches":
+		// The regex is evaluated by All Quiet, so .NET-only constructs are only a warning
+		diags.Append(validateStringValue(ctx, MappingRegexValidator("Not a valid regular expression"), assertion.Value, assertionPath.AtName("value"))...)
+	}
+
+	return diags
+}
+
 func (r *Integration) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -527,6 +1547,7 @@ func (r *Integration) Create(ctx context.Context, req resource.CreateRequest, re
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(applyIntegrationWriteOnlySecrets(ctx, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -537,6 +1558,7 @@ func (r *Integration) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	keepPlannedIsMuted(integrationResponse, &data)
 	mapIntegrationResponseToModel(ctx, integrationResponse, &data)
 
 	tflog.Trace(ctx, "created integration resource")
@@ -575,6 +1597,16 @@ func (r *Integration) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	// Read Terraform plan data into the model
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(applyIntegrationWriteOnlySecrets(ctx, req.Config, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state IntegrationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -586,10 +1618,27 @@ func (r *Integration) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	keepPlannedIsMuted(integrationResponse, &data)
 	mapIntegrationResponseToModel(ctx, integrationResponse, &data)
 
 	tflog.Trace(ctx, "updated integration resource")
 
+	if isWebhookUrlRotation(state.WebhookUrlRotationTrigger, data.WebhookUrlRotationTrigger) {
+		rotationResponse, err := r.client.RotateIntegrationWebhookUrl(ctx, data.Id.ValueString(), data.WebhookUrlRotationGracePeriodInMinutes.ValueInt64Pointer())
+		if err != nil {
+			// The update is kept in the state with the prior trigger, so the rotation is retried on the next apply.
+			data.WebhookUrlRotationTrigger = state.WebhookUrlRotationTrigger
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate integration webhook url, got error: %s", err))
+			return
+		}
+
+		keepPlannedIsMuted(rotationResponse, &data)
+		mapIntegrationResponseToModel(ctx, rotationResponse, &data)
+
+		tflog.Trace(ctx, "rotated integration webhook url")
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -618,18 +1667,57 @@ func (r *Integration) ImportState(ctx context.Context, req resource.ImportStateR
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// applyIntegrationWriteOnlySecrets copies the write-only hmac secret of the config into data, as it is always
+// null in the plan.
+func applyIntegrationWriteOnlySecrets(ctx context.Context, config tfsdk.Config, data *IntegrationModel) diag.Diagnostics {
+	if data.WebhookAuthentication == nil || data.WebhookAuthentication.Hmac == nil {
+		return nil
+	}
+
+	return config.GetAttribute(ctx, path.Root("webhook_authentication").AtName("hmac").AtName("secret"), &data.WebhookAuthentication.Hmac.Secret)
+}
+
+// keepPlannedIsMuted keeps is_muted as planned from muted_until, which may have passed between plan and apply.
+// The next refresh reads the expired mute from All Quiet.
+func keepPlannedIsMuted(response *integrationResponse, plan *IntegrationModel) {
+	if !plan.MutedUntil.IsNull() && !plan.IsMuted.IsUnknown() {
+		response.IsMuted = plan.IsMuted.ValueBool()
+	}
+}
+
+// mapMutedSinceResponseToModel returns mutedSince of All Quiet. If it is not returned, the time the integration
+// was first seen muted is recorded instead, so the mute age can be warned about.
+func mapMutedSinceResponseToModel(response *integrationResponse, prior types.String) types.String {
+	if !response.IsMuted {
+		return types.StringNull()
+	}
+
+	if response.MutedSince != nil {
+		return types.StringValue(*response.MutedSince)
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() {
+		return prior
+	}
+
+	return types.StringValue(time.Now().UTC().Format(time.RFC3339))
+}
+
 func mapIntegrationResponseToModel(ctx context.Context, response *integrationResponse, data *IntegrationModel) {
 
 	data.Id = types.StringValue(response.Id)
 	data.DisplayName = types.StringValue(response.DisplayName)
 	data.TeamId = types.StringValue(response.TeamId)
 	data.IsMuted = types.BoolValue(response.IsMuted)
+	data.MutedUntil = types.StringPointerValue(response.MutedUntil)
+	data.MutedSince = mapMutedSinceResponseToModel(response, data.MutedSince)
 	data.IsInMaintenance = types.BoolValue(response.IsInMaintenance)
 	data.Type = types.StringValue(response.Type)
 	data.Labels = MapNullableList(ctx, response.Labels)
 	data.WebhookUrl = types.StringPointerValue(response.WebhookUrl)
+	data.PreviousWebhookUrlValidUntil = types.StringPointerValue(response.PreviousWebhookUrlValidUntil)
 	data.SnoozeSettings = mapSnoozeSettingsResponseToModel(ctx, response.SnoozeSettings)
-	data.WebhookAuthentication = mapWebhookAuthenticationResponseToModel(response.WebhookAuthentication)
+	data.WebhookAuthentication = mapWebhookAuthenticationResponseToModel(ctx, response.WebhookAuthentication, data.WebhookAuthentication)
 	data.IntegrationSettings = mapIntegrationSettingsResponseToModel(ctx, response.IntegrationSettings)
 }
 
@@ -640,19 +1728,27 @@ func mapIntegrationSettingsResponseToModel(ctx context.Context, response *integr
 
 	// Check if all fields are nil - if so, return nil
 	if response.HttpMonitoring == nil &&
+		response.HttpTransactionMonitor == nil &&
 		response.HeartbeatMonitor == nil &&
 		response.CronjobMonitor == nil &&
 		response.PingMonitor == nil &&
+		response.TcpMonitor == nil &&
+		response.DnsMonitor == nil &&
+		response.CertificateMonitor == nil &&
 		response.Email == nil {
 		return nil
 	}
 
 	return &IntegrationSettingsModel{
-		HttpMonitoring:   mapHttpMonitoringResponseToModel(ctx, response.HttpMonitoring),
-		HeartbeatMonitor: mapHeartbeatMonitorResponseToModel(response.HeartbeatMonitor),
-		CronjobMonitor:   mapCronjobMonitorResponseToModel(response.CronjobMonitor),
-		PingMonitor:      mapPingMonitorResponseToModel(response.PingMonitor),
-		Email:            mapEmailResponseToModel(ctx, response.Email),
+		HttpMonitoring:         mapHttpMonitoringResponseToModel(ctx, response.HttpMonitoring),
+		HttpTransactionMonitor: mapHttpTransactionMonitorResponseToModel(ctx, response.HttpTransactionMonitor),
+		HeartbeatMonitor:       mapHeartbeatMonitorResponseToModel(response.HeartbeatMonitor),
+		CronjobMonitor:         mapCronjobMonitorResponseToModel(ctx, response.CronjobMonitor),
+		PingMonitor:            mapPingMonitorResponseToModel(response.PingMonitor),
+		TcpMonitor:             mapTcpMonitorResponseToModel(response.TcpMonitor),
+		DnsMonitor:             mapDnsMonitorResponseToModel(ctx, response.DnsMonitor),
+		CertificateMonitor:     mapCertificateMonitorResponseToModel(response.CertificateMonitor),
+		Email:                  mapEmailResponseToModel(ctx, response.Email),
 	}
 }
 
@@ -684,6 +1780,62 @@ func mapPingMonitorResponseToModel(response *pingMonitorResponse) *PingMonitorMo
 	}
 }
 
+func mapTcpMonitorResponseToModel(response *tcpMonitorResponse) *TcpMonitorModel {
+	if response == nil {
+		return nil
+	}
+
+	return &TcpMonitorModel{
+		Host:                  types.StringValue(response.Host),
+		Port:                  types.Int64Value(response.Port),
+		TimeoutInMilliseconds: types.Int64Value(response.TimeoutInMilliseconds),
+		MaxRetries:            types.Int64Value(response.MaxRetries),
+		IntervalInSeconds:     types.Int64Value(response.IntervalInSeconds),
+		SeverityDegraded:      types.StringPointerValue(response.SeverityDegraded),
+		SeverityDown:          types.StringPointerValue(response.SeverityDown),
+		IsPaused:              types.BoolValue(response.IsPaused),
+	}
+}
+
+func mapDnsMonitorResponseToModel(ctx context.Context, response *dnsMonitorResponse) *DnsMonitorModel {
+	if response == nil {
+		return nil
+	}
+
+	return &DnsMonitorModel{
+		Name:                  types.StringValue(response.Name),
+		RecordType:            types.StringValue(response.RecordType),
+		ExpectedValues:        MapNullableList(ctx, response.ExpectedValues),
+		Resolver:              types.StringPointerValue(response.Resolver),
+		TimeoutInMilliseconds: types.Int64Value(response.TimeoutInMilliseconds),
+		MaxRetries:            types.Int64Value(response.MaxRetries),
+		IntervalInSeconds:     types.Int64Value(response.IntervalInSeconds),
+		SeverityDegraded:      types.StringPointerValue(response.SeverityDegraded),
+		SeverityDown:          types.StringPointerValue(response.SeverityDown),
+		IsPaused:              types.BoolValue(response.IsPaused),
+	}
+}
+
+func mapCertificateMonitorResponseToModel(response *certificateMonitorResponse) *CertificateMonitorModel {
+	if response == nil {
+		return nil
+	}
+
+	return &CertificateMonitorModel{
+		Host:                     types.StringValue(response.Host),
+		Port:                     types.Int64Value(response.Port),
+		Sni:                      types.StringPointerValue(response.Sni),
+		DaysBeforeExpiryDegraded: types.Int64PointerValue(response.DaysBeforeExpiryDegraded),
+		DaysBeforeExpiryDown:     types.Int64PointerValue(response.DaysBeforeExpiryDown),
+		TimeoutInMilliseconds:    types.Int64Value(response.TimeoutInMilliseconds),
+		MaxRetries:               types.Int64Value(response.MaxRetries),
+		IntervalInSeconds:        types.Int64Value(response.IntervalInSeconds),
+		SeverityDegraded:         types.StringPointerValue(response.SeverityDegraded),
+		SeverityDown:             types.StringPointerValue(response.SeverityDown),
+		IsPaused:                 types.BoolValue(response.IsPaused),
+	}
+}
+
 func mapHeartbeatMonitorResponseToModel(response *heartbeatMonitorResponse) *HeartbeatMonitorModel {
 	if response == nil {
 		return nil
@@ -693,10 +1845,13 @@ func mapHeartbeatMonitorResponseToModel(response *heartbeatMonitorResponse) *Hea
 		IntervalInSec:    types.Int64Value(response.IntervalInSec),
 		GracePeriodInSec: types.Int64Value(response.GracePeriodInSec),
 		Severity:         types.StringValue(response.Severity),
+		PingUrl:          types.StringPointerValue(response.PingUrl),
+		PingUrlStart:     types.StringPointerValue(response.PingUrlStart),
+		PingUrlFail:      types.StringPointerValue(response.PingUrlFail),
 	}
 }
 
-func mapCronjobMonitorResponseToModel(response *cronjobMonitorResponse) *CronjobMonitorModel {
+func mapCronjobMonitorResponseToModel(ctx context.Context, response *cronjobMonitorResponse) *CronjobMonitorModel {
 
 	if response == nil {
 		return nil
@@ -707,6 +1862,10 @@ func mapCronjobMonitorResponseToModel(response *cronjobMonitorResponse) *Cronjob
 		GracePeriodInSec: types.Int64Value(response.GracePeriodInSec),
 		Severity:         types.StringValue(response.Severity),
 		TimeZoneId:       types.StringPointerValue(response.TimeZoneId),
+		NextRuns:         mapCronNextRunsToList(ctx, response.CronExpression, response.TimeZoneId),
+		PingUrl:          types.StringPointerValue(response.PingUrl),
+		PingUrlStart:     types.StringPointerValue(response.PingUrlStart),
+		PingUrlFail:      types.StringPointerValue(response.PingUrlFail),
 	}
 }
 
@@ -725,7 +1884,7 @@ func mapHttpMonitoringResponseToModel(ctx context.Context, response *httpMonitor
 		BasicAuthenticationUsername:        types.StringPointerValue(response.BasicAuthenticationUsername),
 		BasicAuthenticationPassword:        types.StringPointerValue(response.BasicAuthenticationPassword),
 		BearerAuthenticationToken:          types.StringPointerValue(response.BearerAuthenticationToken),
-		Headers:                            mapHeadersResponseToModel(response.Headers),
+		Headers:                            MapNullableStringMap(response.Headers),
 		Body:                               types.StringPointerValue(response.Body),
 		IsPaused:                           types.BoolValue(response.IsPaused),
 		ContentTest:                        types.StringPointerValue(response.ContentTest),
@@ -737,38 +1896,120 @@ func mapHttpMonitoringResponseToModel(ctx context.Context, response *httpMonitor
 		IgnoreNonHttpErrors:                types.BoolValue(response.IgnoreNonHttpErrors),
 	}
 }
-func mapHeadersResponseToModel(response *map[string]string) types.Map {
+
+func mapHttpTransactionMonitorResponseToModel(ctx context.Context, response *httpTransactionMonitorResponse) *HttpTransactionMonitorModel {
 	if response == nil {
-		return types.MapNull(types.StringType)
+		return nil
+	}
+
+	result := &HttpTransactionMonitorModel{
+		TimeoutInMilliseconds:       types.Int64Value(response.TimeoutInMilliseconds),
+		MaxRetries:                  types.Int64Value(response.MaxRetries),
+		IntervalInSeconds:           types.Int64Value(response.IntervalInSeconds),
+		AuthenticationType:          types.StringPointerValue(response.AuthenticationType),
+		BasicAuthenticationUsername: types.StringPointerValue(response.BasicAuthenticationUsername),
+		BasicAuthenticationPassword: types.StringPointerValue(response.BasicAuthenticationPassword),
+		BearerAuthenticationToken:   types.StringPointerValue(response.BearerAuthenticationToken),
+		SeverityDegraded:            types.StringPointerValue(response.SeverityDegraded),
+		SeverityDown:                types.StringPointerValue(response.SeverityDown),
+		IsPaused:                    types.BoolValue(response.IsPaused),
+		IgnoreNonHttpErrors:         types.BoolValue(response.IgnoreNonHttpErrors),
+		Steps:                       make([]HttpTransactionStepModel, len(response.Steps)),
+	}
+
+	for i, step := range response.Steps {
+		result.Steps[i] = HttpTransactionStepModel{
+			Name:                types.StringValue(step.Name),
+			Method:              types.StringValue(step.Method),
+			Url:                 types.StringValue(step.Url),
+			Headers:             MapNullableStringMap(step.Headers),
+			Body:                types.StringPointerValue(step.Body),
+			ExpectedStatusCodes: MapIntSliceToNullableList(ctx, step.ExpectedStatusCodes),
+			Extractors:          mapHttpTransactionExtractorsResponseToModel(step.Extractors),
+			Assertions:          mapHttpTransactionAssertionsResponseToModel(step.Assertions),
+		}
 	}
 
-	// Convert map[string]string to map[string]attr.Value
-	elements := make(map[string]attr.Value, len(*response))
-	for k, v := range *response {
-		elements[k] = types.StringValue(v)
+	return result
+}
+
+func mapHttpTransactionExtractorsResponseToModel(response *[]httpTransactionExtractorResponse) *[]HttpTransactionExtractorModel {
+	if response == nil {
+		return nil
 	}
 
-	val, diags := types.MapValue(types.StringType, elements)
-	if diags.HasError() {
-		// In production code, you'd probably want to return an error here
-		// but for now just fallback to null if conversion fails
-		return types.MapNull(types.StringType)
+	result := make([]HttpTransactionExtractorModel, len(*response))
+	for i, extractor := range *response {
+		result[i] = HttpTransactionExtractorModel{
+			Variable: types.StringValue(extractor.Variable),
+			JSONPath: types.StringPointerValue(extractor.JSONPath),
+			Regex:    types.StringPointerValue(extractor.Regex),
+			Header:   types.StringPointerValue(extractor.Header),
+		}
 	}
 
-	return val
+	return &result
 }
 
-func mapWebhookAuthenticationResponseToModel(response *webhookAuthenticationResponse) *WebhookAuthenticationModel {
+func mapHttpTransactionAssertionsResponseToModel(response *[]httpTransactionAssertionResponse) *[]HttpTransactionAssertionModel {
 	if response == nil {
 		return nil
 	}
 
+	result := make([]HttpTransactionAssertionModel, len(*response))
+	for i, assertion := range *response {
+		result[i] = HttpTransactionAssertionModel{
+			Source:     types.StringValue(assertion.Source),
+			Property:   types.StringPointerValue(assertion.Property),
+			Comparison: types.StringValue(assertion.Comparison),
+			Value:      types.StringValue(assertion.Value),
+		}
+	}
+
+	return &result
+}
+
+func mapWebhookAuthenticationResponseToModel(ctx context.Context, response *webhookAuthenticationResponse, prior *WebhookAuthenticationModel) *WebhookAuthenticationModel {
+	if response == nil {
+		return nil
+	}
+
+	var priorHmac *HmacModel
+	if prior != nil {
+		priorHmac = prior.Hmac
+	}
+
 	return &WebhookAuthenticationModel{
-		Type:   types.StringValue(response.Type),
-		Bearer: mapBearerResponseToModel(response.Bearer),
+		Type:               types.StringPointerValue(response.Type),
+		Bearer:             mapBearerResponseToModel(response.Bearer),
+		Hmac:               mapHmacResponseToModel(response.Hmac, priorHmac),
+		AllowedSourceCidrs: MapNullableList(ctx, response.AllowedSourceCidrs),
 	}
 }
 
+// mapHmacResponseToModel keeps the secret version of the prior state. The write-only secret is never stored in
+// the state.
+func mapHmacResponseToModel(response *webhookAuthenticationHmacResponse, prior *HmacModel) *HmacModel {
+	if response == nil {
+		return nil
+	}
+
+	result := &HmacModel{
+		SignatureHeader:             types.StringValue(response.SignatureHeader),
+		Algorithm:                   types.StringValue(response.Algorithm),
+		Secret:                      types.StringNull(),
+		SecretVersion:               types.Int64Null(),
+		TimestampHeader:             types.StringPointerValue(response.TimestampHeader),
+		TimestampToleranceInSeconds: types.Int64PointerValue(response.TimestampToleranceInSeconds),
+	}
+
+	if prior != nil {
+		result.SecretVersion = prior.SecretVersion
+	}
+
+	return result
+}
+
 func mapBearerResponseToModel(response *webhookAuthenticationBearerResponse) *BearerModel {
 	if response == nil {
 		return nil
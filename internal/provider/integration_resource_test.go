@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -138,6 +139,7 @@ func TestAccIntegrationResourceExample(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks:   testAccWriteOnlyTerraformVersionChecks,
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -168,6 +170,269 @@ func TestAccIntegrationResourceExample(t *testing.T) {
 	})
 }
 
+func TestAccIntegrationResourceSyntheticMonitors(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Days before expiry down must be less than degraded
+			{
+				Config:      testAccIntegrationResourceSyntheticMonitorsConfig(7, 30),
+				ExpectError: regexp.MustCompile(`must be less than days_before_expiry_degraded`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIntegrationResourceSyntheticMonitorsConfig(30, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration.tcp", "integration_settings.tcp_monitor.port", "5432"),
+					resource.TestCheckResourceAttr("allquiet_integration.tcp", "integration_settings.tcp_monitor.max_retries", "0"),
+					resource.TestCheckResourceAttr("allquiet_integration.dns", "integration_settings.dns_monitor.record_type", "A"),
+					resource.TestCheckResourceAttr("allquiet_integration.dns", "integration_settings.dns_monitor.expected_values.#", "1"),
+					resource.TestCheckResourceAttr("allquiet_integration.certificate", "integration_settings.certificate_monitor.port", "443"),
+					resource.TestCheckResourceAttr("allquiet_integration.certificate", "integration_settings.certificate_monitor.days_before_expiry_degraded", "30"),
+					resource.TestCheckResourceAttr("allquiet_integration.certificate", "integration_settings.certificate_monitor.days_before_expiry_down", "7"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_integration.certificate",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccIntegrationResourceSyntheticMonitorsConfig(21, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration.certificate", "integration_settings.certificate_monitor.days_before_expiry_degraded", "21"),
+					resource.TestCheckResourceAttr("allquiet_integration.certificate", "integration_settings.certificate_monitor.days_before_expiry_down", "3"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIntegrationResourceHttpTransactionMonitor(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Variables must be extracted by a previous step
+			{
+				Config:      testAccIntegrationResourceHttpTransactionMonitorConfig(`"token"`, "{{session}}"),
+				ExpectError: regexp.MustCompile(`references variable "session", which is not extracted by a previous step`),
+			},
+			// References cannot be checked while a variable is unknown
+			{
+				Config:             testAccIntegrationResourceHttpTransactionMonitorConfig("terraform_data.variable.output", "{{session}}"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Create and Read testing
+			{
+				Config: testAccIntegrationResourceHttpTransactionMonitorConfig(`"token"`, "{{token}}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration.test", "integration_settings.http_transaction_monitor.steps.#", "2"),
+					resource.TestCheckResourceAttr("allquiet_integration.test", "integration_settings.http_transaction_monitor.steps.0.extractors.0.variable", "token"),
+					resource.TestCheckResourceAttr("allquiet_integration.test", "integration_settings.http_transaction_monitor.steps.1.assertions.0.comparison", "Contains"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_integration.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIntegrationResourceCronjobMonitor(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIntegrationResourceCronjobMonitorConfig("*/0 * * * *"),
+				ExpectError: regexp.MustCompile(`Invalid Cron Expression`),
+			},
+			{
+				Config:      testAccIntegrationResourceCronjobMonitorConfig("0 0 31 4 *"),
+				ExpectError: regexp.MustCompile(`never runs`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIntegrationResourceCronjobMonitorConfig("30 */10 * * * *"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration.test", "integration_settings.cronjob_monitor.next_runs.#", "5"),
+					resource.TestCheckResourceAttrSet("allquiet_integration.test", "integration_settings.cronjob_monitor.ping_url"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIntegrationResourceMutedUntil(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIntegrationResourceMutedUntilConfig(`is_muted = true` + "\n" + `muted_until = "2030-01-31T18:00:00Z"`),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config:      testAccIntegrationResourceMutedUntilConfig(`muted_until = "2030-01-31"`),
+				ExpectError: regexp.MustCompile(`Not a valid date / time`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIntegrationResourceMutedUntilConfig(`muted_until = "2030-01-31T18:00:00Z"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration.test", "is_muted", "true"),
+					resource.TestCheckResourceAttr("allquiet_integration.test", "muted_until", "2030-01-31T18:00:00Z"),
+					resource.TestCheckResourceAttrSet("allquiet_integration.test", "muted_since"),
+				),
+			},
+			// A mute that has already expired does not mute the integration
+			{
+				Config: testAccIntegrationResourceMutedUntilConfig(`muted_until = "2020-01-31T18:00:00Z"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration.test", "is_muted", "false"),
+					resource.TestCheckNoResourceAttr("allquiet_integration.test", "muted_since"),
+				),
+			},
+			// muted_since is recorded when the integration is muted without muted_until
+			{
+				Config: testAccIntegrationResourceMutedUntilConfig(`is_muted = true`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration.test", "is_muted", "true"),
+					resource.TestCheckResourceAttrSet("allquiet_integration.test", "muted_since"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIntegrationResourceWebhookHmacAuthentication(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks:   testAccWriteOnlyTerraformVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationResourceWebhookAuthenticationConfig(`
+    type = "hmac"
+    bearer = {
+      token = "my-token"
+    }`),
+				ExpectError: regexp.MustCompile(`hmac must be specified`),
+			},
+			{
+				Config: testAccIntegrationResourceWebhookAuthenticationConfig(`
+    bearer = {
+      token = "my-token"
+    }`),
+				ExpectError: regexp.MustCompile(`bearer can only be specified when the webhook authentication type is 'bearer'`),
+			},
+			{
+				Config: testAccIntegrationResourceWebhookAuthenticationConfig(`
+    type = "bearer"
+    bearer = {
+      token = "my-token"
+    }
+    allowed_source_cidrs = ["10.0.0.1/8"]`),
+				ExpectError: regexp.MustCompile(`did you mean 10.0.0.0/8`),
+			},
+			{
+				Config: testAccIntegrationResourceWebhookAuthenticationConfig(`
+    type = "hmac"
+    hmac = {
+      signature_header               = "X-Signature"
+      secret                         = "whsec_0123456789abcdef"
+      timestamp_tolerance_in_seconds = 300
+    }`),
+				ExpectError: regexp.MustCompile(`timestamp_header`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIntegrationResourceWebhookAuthenticationConfig(`
+    type = "hmac"
+    hmac = {
+      signature_header               = "X-Signature"
+      secret                         = "whsec_0123456789abcdef"
+      secret_version                 = 1
+      timestamp_header               = "X-Timestamp"
+      timestamp_tolerance_in_seconds = 120
+    }
+    allowed_source_cidrs = ["192.0.2.0/24", "2001:db8::/32"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_integration.test", "webhook_authentication.type", "hmac"),
+					resource.TestCheckResourceAttr("allquiet_integration.test", "webhook_authentication.hmac.algorithm", "sha256"),
+					resource.TestCheckNoResourceAttr("allquiet_integration.test", "webhook_authentication.hmac.secret"),
+					resource.TestCheckResourceAttr("allquiet_integration.test", "webhook_authentication.hmac.secret_version", "1"),
+					resource.TestCheckResourceAttr("allquiet_integration.test", "webhook_authentication.hmac.timestamp_header", "X-Timestamp"),
+					resource.TestCheckResourceAttr("allquiet_integration.test", "webhook_authentication.hmac.timestamp_tolerance_in_seconds", "120"),
+					resource.TestCheckResourceAttr("allquiet_integration.test", "webhook_authentication.allowed_source_cidrs.#", "2"),
+				),
+			},
+			// Source restriction without authentication
+			{
+				Config: testAccIntegrationResourceWebhookAuthenticationConfig(`
+    allowed_source_cidrs = ["192.0.2.0/24"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("allquiet_integration.test", "webhook_authentication.type"),
+					resource.TestCheckNoResourceAttr("allquiet_integration.test", "webhook_authentication.hmac"),
+					resource.TestCheckResourceAttr("allquiet_integration.test", "webhook_authentication.allowed_source_cidrs.0", "192.0.2.0/24"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccIntegrationResourceWebhookUrlRotation(t *testing.T) {
+	var webhookUrl string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIntegrationResourceWebhookUrlRotationConfig(`webhook_url_rotation_grace_period_in_minutes = 60`),
+				ExpectError: regexp.MustCompile(`webhook_url_rotation_trigger`),
+			},
+			// Create and Read testing
+			{
+				Config: testAccIntegrationResourceWebhookUrlRotationConfig(`webhook_url_rotation_trigger = "1"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("allquiet_integration.test", "webhook_url", func(value string) error {
+						webhookUrl = value
+						return nil
+					}),
+				),
+			},
+			// Changing the trigger rotates the webhook url in place
+			{
+				Config: testAccIntegrationResourceWebhookUrlRotationConfig(`webhook_url_rotation_trigger = "2"` + "\n" + `webhook_url_rotation_grace_period_in_minutes = 60`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("allquiet_integration.test", "webhook_url", func(value string) error {
+						if value == webhookUrl {
+							return fmt.Errorf("expected webhook_url to be rotated, still %s", value)
+						}
+						return nil
+					}),
+					resource.TestCheckResourceAttrSet("allquiet_integration.test", "previous_webhook_url_valid_until"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
 func testAccIntegrationResourceConfig(display_name string) string {
 	result := fmt.Sprintf(`
 resource "allquiet_team" "test" {
@@ -380,6 +645,185 @@ resource "allquiet_integration" "email_with_aliases" {
 	return replaceEmailAliases(result)
 }
 
+func testAccIntegrationResourceSyntheticMonitorsConfig(daysDegraded int, daysDown int) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "tcp" {
+  display_name = "TCP Monitor"
+  team_id      = allquiet_team.test.id
+  type         = "TcpMonitor"
+  integration_settings = {
+    tcp_monitor = {
+      host                    = "allquiet.app"
+      port                    = 5432
+      timeout_in_milliseconds = 1000
+      interval_in_seconds     = 60
+      severity_down           = "Critical"
+    }
+  }
+}
+
+resource "allquiet_integration" "dns" {
+  display_name = "DNS Monitor"
+  team_id      = allquiet_team.test.id
+  type         = "DnsMonitor"
+  integration_settings = {
+    dns_monitor = {
+      name                    = "allquiet.app"
+      record_type             = "A"
+      expected_values         = ["127.0.0.1"]
+      timeout_in_milliseconds = 500
+      interval_in_seconds     = 300
+      severity_degraded       = "Warning"
+      severity_down           = "Critical"
+    }
+  }
+}
+
+resource "allquiet_integration" "certificate" {
+  display_name = "Certificate Monitor"
+  team_id      = allquiet_team.test.id
+  type         = "CertificateMonitor"
+  integration_settings = {
+    certificate_monitor = {
+      host                        = "allquiet.app"
+      days_before_expiry_degraded = %[1]d
+      days_before_expiry_down     = %[2]d
+      timeout_in_milliseconds     = 5000
+      interval_in_seconds         = 3600
+      severity_degraded           = "Warning"
+      severity_down               = "Critical"
+    }
+  }
+}
+`, daysDegraded, daysDown)
+}
+
+func testAccIntegrationResourceHttpTransactionMonitorConfig(variable string, authorization string) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "terraform_data" "variable" {
+  input = "session"
+}
+
+resource "allquiet_integration" "test" {
+  display_name = "HTTP Transaction Monitor"
+  team_id      = allquiet_team.test.id
+  type         = "HttpTransactionMonitor"
+  integration_settings = {
+    http_transaction_monitor = {
+      timeout_in_milliseconds = 5000
+      interval_in_seconds     = 300
+      severity_down           = "Critical"
+      steps = [
+        {
+          name   = "login"
+          method = "POST"
+          url    = "https://allquiet.app/api/login"
+          body   = "{}"
+          extractors = [
+            {
+              variable  = %[1]s
+              json_path = "$.token"
+            }
+          ]
+        },
+        {
+          name   = "dashboard"
+          method = "GET"
+          url    = "https://allquiet.app/api/dashboard"
+          headers = {
+            "Authorization" = "Bearer %[2]s"
+          }
+          assertions = [
+            {
+              source     = "Body"
+              comparison = "Contains"
+              value      = "dashboard"
+            }
+          ]
+        }
+      ]
+    }
+  }
+}
+`, variable, authorization)
+}
+
+func testAccIntegrationResourceCronjobMonitorConfig(cronExpression string) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "test" {
+  display_name = "Cronjob Monitor"
+  team_id      = allquiet_team.test.id
+  type         = "CronJobMonitor"
+  integration_settings = {
+    cronjob_monitor = {
+      cron_expression     = %[1]q
+      grace_period_in_sec = 60
+      severity            = "Critical"
+      time_zone_id        = "Europe/Amsterdam"
+    }
+  }
+}
+`, cronExpression)
+}
+
+func testAccIntegrationResourceMutedUntilConfig(mute string) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "test" {
+  display_name = "Muted Integration"
+  team_id      = allquiet_team.test.id
+  type         = "Datadog"
+  %[1]s
+}
+`, mute)
+}
+
+func testAccIntegrationResourceWebhookAuthenticationConfig(authentication string) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "test" {
+  display_name = "Signed Webhook"
+  team_id      = allquiet_team.test.id
+  type         = "Webhook"
+  webhook_authentication = {%[1]s
+  }
+}
+`, authentication)
+}
+
+func testAccIntegrationResourceWebhookUrlRotationConfig(rotation string) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+
+resource "allquiet_integration" "test" {
+  display_name = "Rotated Webhook"
+  team_id      = allquiet_team.test.id
+  type         = "Webhook"
+  %[1]s
+}
+`, rotation)
+}
+
 func testAccIntegrationResourceExample() string {
 	absPath, _ := filepath.Abs("../../examples/resources/allquiet_integration/resource.tf")
 
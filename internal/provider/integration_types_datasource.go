@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IntegrationTypesDataSource{}
+
+func NewInboundIntegrationTypesDataSource() datasource.DataSource {
+	return &IntegrationTypesDataSource{
+		typeNameSuffix: "_inbound_integration_types",
+		path:           "/inbound-integration/types",
+		description:    "Inbound integration types data source. Lists all types that can be used for `allquiet_integration.type`",
+	}
+}
+
+func NewOutboundIntegrationTypesDataSource() datasource.DataSource {
+	return &IntegrationTypesDataSource{
+		typeNameSuffix: "_outbound_integration_types",
+		path:           "/outbound-integration/types",
+		description:    "Outbound integration types data source. Lists all types that can be used for `allquiet_outbound_integration.type`",
+	}
+}
+
+// IntegrationTypesDataSource defines the data source implementation
+// shared by the inbound and outbound integration type catalogs.
+type IntegrationTypesDataSource struct {
+	client         *AllQuietAPIClient
+	typeNameSuffix string
+	path           string
+	description    string
+}
+
+// IntegrationTypesDataSourceModel describes the data source data model.
+type IntegrationTypesDataSourceModel struct {
+	Category types.String                     `tfsdk:"category"`
+	Types    []IntegrationTypeDataSourceModel `tfsdk:"types"`
+}
+
+type IntegrationTypeDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+	Category    types.String `tfsdk:"category"`
+}
+
+func (d *IntegrationTypesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + d.typeNameSuffix
+}
+
+func (d *IntegrationTypesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: d.description,
+		Attributes: map[string]schema.Attribute{
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Category of the integration types to filter by",
+				Optional:            true,
+			},
+			"types": schema.ListNestedAttribute{
+				MarkdownDescription: "List of integration types",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The type id, to be used as the `type` of the integration",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "Display name of the integration type",
+							Computed:            true,
+						},
+						"category": schema.StringAttribute{
+							MarkdownDescription: "Category of the integration type",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IntegrationTypesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IntegrationTypesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IntegrationTypesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationTypesResponse, err := d.client.GetIntegrationTypesDataSource(ctx, d.path)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integration types, got error: %s", err))
+		return
+	}
+
+	mapIntegrationTypesResponseToDataSourceModel(integrationTypesResponse, &data)
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func mapIntegrationTypesResponseToDataSourceModel(integrationTypesResponse []integrationTypeDataSourceResponse, data *IntegrationTypesDataSourceModel) {
+	data.Types = make([]IntegrationTypeDataSourceModel, 0, len(integrationTypesResponse))
+
+	for _, integrationType := range integrationTypesResponse {
+		if data.Category.ValueString() != "" && data.Category.ValueString() != integrationType.Category {
+			continue
+		}
+
+		data.Types = append(data.Types, IntegrationTypeDataSourceModel{
+			Id:          types.StringValue(integrationType.Id),
+			DisplayName: types.StringValue(integrationType.DisplayName),
+			Category:    types.StringValue(integrationType.Category),
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type integrationTypeDataSourceResponse struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Category    string `json:"category"`
+}
+
+// GetIntegrationTypesDataSource fetches the type catalog at path, either
+// /inbound-integration/types or /outbound-integration/types.
+func (c *AllQuietAPIClient) GetIntegrationTypesDataSource(ctx context.Context, path string) ([]integrationTypeDataSourceResponse, error) {
+	httpResp, err := c.get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, logErrorResponse(httpResp, nil)
+	}
+
+	var result []integrationTypeDataSourceResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
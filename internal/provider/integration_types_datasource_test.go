@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationTypesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationTypesDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.allquiet_inbound_integration_types.test", "types.#"),
+					resource.TestCheckResourceAttrSet("data.allquiet_inbound_integration_types.test", "types.0.id"),
+					resource.TestCheckResourceAttrSet("data.allquiet_inbound_integration_types.test", "types.0.display_name"),
+					resource.TestCheckResourceAttrSet("data.allquiet_outbound_integration_types.test", "types.#"),
+					resource.TestCheckResourceAttrSet("data.allquiet_outbound_integration_types.test", "types.0.id"),
+					resource.TestCheckResourceAttrSet("data.allquiet_outbound_integration_types.test", "types.0.display_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationTypesDataSourceConfig() string {
+	return `
+		data "allquiet_inbound_integration_types" "test" {
+		}
+
+		data "allquiet_outbound_integration_types" "test" {
+		}
+	`
+}
+
+func TestAccInboundIntegrationTypesDataSourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationTypesDataSourceExample("allquiet_inbound_integration_types"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.allquiet_inbound_integration_types.all", "types.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOutboundIntegrationTypesDataSourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationTypesDataSourceExample("allquiet_outbound_integration_types"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.allquiet_outbound_integration_types.all", "types.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationTypesDataSourceExample(dataSourceName string) string {
+	absPath, _ := filepath.Abs("../../examples/data-sources/" + dataSourceName + "/data-source.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(dat)
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IntegrationsDataSource{}
+
+func NewIntegrationsDataSource() datasource.DataSource {
+	return &IntegrationsDataSource{}
+}
+
+// IntegrationsDataSource defines the data source implementation.
+type IntegrationsDataSource struct {
+	client *AllQuietAPIClient
+}
+
+// IntegrationsDataSourceModel describes the data source data model.
+type IntegrationsDataSourceModel struct {
+	DisplayName  types.String                 `tfsdk:"display_name"`
+	TeamId       types.String                 `tfsdk:"team_id"`
+	Type         types.String                 `tfsdk:"type"`
+	IsMuted      types.Bool                   `tfsdk:"is_muted"`
+	MuteExpired  types.Bool                   `tfsdk:"mute_expired"`
+	Integrations []IntegrationDataSourceModel `tfsdk:"integrations"`
+}
+
+type IntegrationDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	DisplayName     types.String `tfsdk:"display_name"`
+	TeamId          types.String `tfsdk:"team_id"`
+	Type            types.String `tfsdk:"type"`
+	Labels          types.List   `tfsdk:"labels"`
+	IsMuted         types.Bool   `tfsdk:"is_muted"`
+	MutedUntil      types.String `tfsdk:"muted_until"`
+	MutedSince      types.String `tfsdk:"muted_since"`
+	MuteExpired     types.Bool   `tfsdk:"mute_expired"`
+	IsInMaintenance types.Bool   `tfsdk:"is_in_maintenance"`
+}
+
+func (d *IntegrationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integrations"
+}
+
+func (d *IntegrationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Integrations data source",
+		Attributes: map[string]schema.Attribute{
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the integrations to look up",
+				Optional:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Only return integrations of this team",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only return integrations of this type",
+				Optional:            true,
+			},
+			"is_muted": schema.BoolAttribute{
+				MarkdownDescription: "Only return integrations that are muted (true) or not muted (false)",
+				Optional:            true,
+			},
+			"mute_expired": schema.BoolAttribute{
+				MarkdownDescription: "Only return integrations whose mute has (true) or has not (false) expired, see `mute_expired` of the integrations",
+				Optional:            true,
+			},
+			"integrations": schema.ListNestedAttribute{
+				MarkdownDescription: "List of integrations",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Integration ID",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "Display name of the integration",
+							Computed:            true,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "Team ID of the integration",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the integration",
+							Computed:            true,
+						},
+						"labels": schema.ListAttribute{
+							MarkdownDescription: "Labels of the integration",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"is_muted": schema.BoolAttribute{
+							MarkdownDescription: "If the integration is muted",
+							Computed:            true,
+						},
+						"muted_until": schema.StringAttribute{
+							MarkdownDescription: "Until when the integration is muted",
+							Computed:            true,
+						},
+						"muted_since": schema.StringAttribute{
+							MarkdownDescription: "Since when the integration is muted",
+							Computed:            true,
+						},
+						"mute_expired": schema.BoolAttribute{
+							MarkdownDescription: "If the integration is still muted after `muted_until`, or has been muted without `muted_until` for longer than the provider's `mute_warning_threshold_in_days`",
+							Computed:            true,
+						},
+						"is_in_maintenance": schema.BoolAttribute{
+							MarkdownDescription: "If the integration is in maintenance mode",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IntegrationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *IntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IntegrationsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrationsResponse, err := d.client.GetIntegrationsDataSource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get integrations, got error: %s", err))
+		return
+	}
+
+	if integrationsResponse == nil {
+		integrationsResponse = &integrationsDataSourceResponse{}
+	}
+
+	mapIntegrationsResponseToDataSourceModel(ctx, integrationsResponse, &data, d.client.MuteWarningThresholdInDays, time.Now())
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// isMuteExpired reports whether the integration is still muted after muted_until, or has been muted
+// without muted_until for longer than the threshold.
+func isMuteExpired(integration *integrationResponse, thresholdInDays int64, now time.Time) bool {
+	if !integration.IsMuted {
+		return false
+	}
+
+	if integration.MutedUntil != nil {
+		mutedUntil, err := time.Parse(time.RFC3339, *integration.MutedUntil)
+		return err == nil && mutedUntil.Before(now)
+	}
+
+	if integration.MutedSince == nil {
+		return false
+	}
+
+	mutedSince, err := time.Parse(time.RFC3339, *integration.MutedSince)
+	return err == nil && now.Sub(mutedSince) > time.Duration(thresholdInDays)*24*time.Hour
+}
+
+// mapIntegrationsResponseToDataSourceModel maps the integrations and applies the filters the
+// integration search endpoint does not support.
+func mapIntegrationsResponseToDataSourceModel(ctx context.Context, integrationsResponse *integrationsDataSourceResponse, data *IntegrationsDataSourceModel, thresholdInDays int64, now time.Time) {
+	data.Integrations = make([]IntegrationDataSourceModel, 0, len(integrationsResponse.Integrations))
+
+	for _, integration := range integrationsResponse.Integrations {
+		muteExpired := isMuteExpired(&integration, thresholdInDays, now)
+
+		if !data.IsMuted.IsNull() && data.IsMuted.ValueBool() != integration.IsMuted {
+			continue
+		}
+		if !data.MuteExpired.IsNull() && data.MuteExpired.ValueBool() != muteExpired {
+			continue
+		}
+
+		data.Integrations = append(data.Integrations, IntegrationDataSourceModel{
+			Id:              types.StringValue(integration.Id),
+			DisplayName:     types.StringValue(integration.DisplayName),
+			TeamId:          types.StringValue(integration.TeamId),
+			Type:            types.StringValue(integration.Type),
+			Labels:          MapNullableList(ctx, integration.Labels),
+			IsMuted:         types.BoolValue(integration.IsMuted),
+			MutedUntil:      types.StringPointerValue(integration.MutedUntil),
+			MutedSince:      types.StringPointerValue(integration.MutedSince),
+			MuteExpired:     types.BoolValue(muteExpired),
+			IsInMaintenance: types.BoolValue(integration.IsInMaintenance),
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+)
+
+type integrationsDataSourceResponse struct {
+	pagedResponse
+	Integrations []integrationResponse `json:"integrations"`
+}
+
+func (c *AllQuietAPIClient) GetIntegrationsDataSource(ctx context.Context, integrationsDataSource *IntegrationsDataSourceModel) (*integrationsDataSourceResponse, error) {
+
+	url := getIntegrationsUrl(integrationsDataSource)
+
+	var result integrationsDataSourceResponse
+	found, err := c.getAllPages(ctx, url, func(body io.Reader) (*string, error) {
+		var page integrationsDataSourceResponse
+		if err := json.NewDecoder(body).Decode(&page); err != nil {
+			return nil, err
+		}
+
+		result.Integrations = append(result.Integrations, page.Integrations...)
+		return page.ContinuationToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	return &result, nil
+}
+
+func getIntegrationsUrl(integrationsDataSource *IntegrationsDataSourceModel) string {
+
+	url := "/inbound-integration/search/list"
+	if integrationsDataSource.DisplayName.ValueStringPointer() != nil {
+		url = AddQueryParam(url, "displayName", integrationsDataSource.DisplayName.ValueString())
+	}
+	if integrationsDataSource.TeamId.ValueStringPointer() != nil {
+		url = AddQueryParam(url, "teamId", integrationsDataSource.TeamId.ValueString())
+	}
+	if integrationsDataSource.Type.ValueStringPointer() != nil {
+		url = AddQueryParam(url, "type", integrationsDataSource.Type.ValueString())
+	}
+
+	return url
+}
@@ -0,0 +1,102 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIntegrationsDataSource(t *testing.T) {
+	uid := uuid.New().String()
+	displayName := fmt.Sprintf("TF Acceptance Test %s", uid)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationsDataSourceConfig(displayName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.allquiet_integrations.test_by_display_name", "integrations.#", "2"),
+					resource.TestCheckResourceAttr("data.allquiet_integrations.test_muted", "integrations.#", "1"),
+					resource.TestCheckResourceAttr("data.allquiet_integrations.test_muted", "integrations.0.muted_until", "2030-01-31T18:00:00Z"),
+					resource.TestCheckResourceAttr("data.allquiet_integrations.test_muted", "integrations.0.mute_expired", "false"),
+					resource.TestCheckResourceAttr("data.allquiet_integrations.test_expired_mutes", "integrations.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationsDataSourceConfig(displayName string) string {
+	return fmt.Sprintf(`
+
+		resource "allquiet_team" "test" {
+			display_name = "Root"
+		}
+
+		resource "allquiet_integration" "test1" {
+			display_name = "%[1]s 1"
+			team_id      = allquiet_team.test.id
+			type         = "Datadog"
+		}
+
+		resource "allquiet_integration" "test2" {
+			display_name = "%[1]s 2"
+			team_id      = allquiet_team.test.id
+			type         = "Datadog"
+			muted_until  = "2030-01-31T18:00:00Z"
+		}
+
+		data "allquiet_integrations" "test_by_display_name" {
+			display_name = "%[1]s"
+			depends_on   = [allquiet_integration.test1, allquiet_integration.test2]
+		}
+
+		data "allquiet_integrations" "test_muted" {
+			display_name = "%[1]s"
+			is_muted     = true
+			depends_on   = [allquiet_integration.test1, allquiet_integration.test2]
+		}
+
+		data "allquiet_integrations" "test_expired_mutes" {
+			display_name = "%[1]s"
+			mute_expired = true
+			depends_on   = [allquiet_integration.test1, allquiet_integration.test2]
+		}
+	`, displayName)
+}
+
+func TestAccIntegrationsDataSourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIntegrationsDataSourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.allquiet_integrations.datadog", "integrations.#"),
+					resource.TestCheckResourceAttrSet("data.allquiet_integrations.expired_mutes", "integrations.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIntegrationsDataSourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/data-sources/allquiet_integrations/data-source.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(dat)
+}
@@ -109,6 +109,10 @@ func (c *AllQuietAPIClient) GetOnCallOverrideResource(ctx context.Context, id st
 	}
 	defer httpResp.Body.Close()
 
+	if httpResp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
 	if httpResp.StatusCode != http.StatusOK {
 		return nil, logErrorResponse(httpResp, nil)
 	}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetOrganizationMembers returns all memberships of the organization.
+func (c *AllQuietAPIClient) GetOrganizationMembers(ctx context.Context) ([]organizationMembershipResponse, error) {
+	return c.GetOrganizationMemberships(ctx, nil, nil)
+}
+
+// UpdateOrganizationMembersResource converges the memberships of the organization on the planned members.
+// Members are added and re-roled before others are removed. Protected users are never removed.
+func (c *AllQuietAPIClient) UpdateOrganizationMembersResource(ctx context.Context, data *OrganizationMembersModel) ([]organizationMembershipResponse, error) {
+	desired := make(map[string]string, len(data.Members))
+	for _, member := range data.Members {
+		userId := member.UserId.ValueString()
+		if _, ok := desired[userId]; ok {
+			return nil, fmt.Errorf("user %s is listed more than once in members", userId)
+		}
+		desired[userId] = member.Role.ValueString()
+	}
+
+	current, err := c.GetOrganizationMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[string]organizationMembershipResponse, len(current))
+	for _, membership := range current {
+		existing[membership.UserId] = membership
+	}
+
+	for _, member := range data.Members {
+		userId := member.UserId.ValueString()
+		membership := &OrganizationMembershipModel{UserId: member.UserId, Role: member.Role}
+
+		existingMembership, ok := existing[userId]
+		if !ok {
+			tflog.Debug(ctx, fmt.Sprintf("adding user %s to the organization", userId))
+			if _, err := c.CreateOrganizationMembershipResource(ctx, membership); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if existingMembership.Role != member.Role.ValueString() {
+			tflog.Debug(ctx, fmt.Sprintf("changing organization role of user %s", userId))
+			if _, err := c.UpdateOrganizationMembershipResource(ctx, existingMembership.Id, membership); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	protectedUserIds := organizationMembersProtectedUserIds(data)
+	for _, membership := range current {
+		if _, ok := desired[membership.UserId]; ok || slices.Contains(protectedUserIds, membership.UserId) {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("removing user %s from the organization", membership.UserId))
+		if err := c.DeleteOrganizationMembershipResource(ctx, membership.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.GetOrganizationMembers(ctx)
+}
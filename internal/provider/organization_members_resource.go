@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OrganizationMembers{}
+var _ resource.ResourceWithImportState = &OrganizationMembers{}
+var _ resource.ResourceWithModifyPlan = &OrganizationMembers{}
+
+const organizationMembersId = "organization-members"
+
+func NewOrganizationMembers() resource.Resource {
+	return &OrganizationMembers{}
+}
+
+type OrganizationMembers struct {
+	client *AllQuietAPIClient
+}
+
+type OrganizationMembersModel struct {
+	Id                      types.String                     `tfsdk:"id"`
+	Members                 []OrganizationMembersMemberModel `tfsdk:"members"`
+	ProtectedUserIds        types.List                       `tfsdk:"protected_user_ids"`
+	RemovalWarningThreshold types.Int64                      `tfsdk:"removal_warning_threshold"`
+}
+
+type OrganizationMembersMemberModel struct {
+	UserId types.String `tfsdk:"user_id"`
+	Role   types.String `tfsdk:"role"`
+}
+
+func (r *OrganizationMembers) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_members"
+}
+
+func (r *OrganizationMembers) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The organization members resource authoritatively manages all members of the organization and their roles. Members that are added outside of Terraform, e.g. through the UI, show up as drift in the plan and are removed on apply. Declare it only once per organization and do not combine it with `allquiet_organization_membership` resources. Destroying this resource does not remove any members from the organization.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: "The complete set of members of the organization. Each user can only be listed once.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "The user id of the member",
+							Required:            true,
+						},
+						"role": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Role of the member. Possible values are: " + strings.Join(ValidOrganizationMembershipRoles, ", "),
+							Validators:          []validator.String{stringvalidator.OneOf(ValidOrganizationMembershipRoles...)},
+						},
+					},
+				},
+			},
+			"protected_user_ids": schema.ListAttribute{
+				MarkdownDescription: "User ids that are never removed from the organization, e.g. break-glass accounts. Protected users that are not listed in `members` are ignored instead of being reported as drift.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"removal_warning_threshold": schema.Int64Attribute{
+				MarkdownDescription: "If set, the plan shows a warning listing the affected users when the change would remove more than this number of members from the organization.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (r *OrganizationMembers) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OrganizationMembers) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing is removed on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan OrganizationMembersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.RemovalWarningThreshold.IsNull() || plan.RemovalWarningThreshold.IsUnknown() {
+		return
+	}
+
+	var currentUserIds []string
+	if req.State.Raw.IsNull() {
+		// On create, the resource takes over all existing members of the organization
+		if r.client == nil {
+			return
+		}
+
+		memberships, err := r.client.GetOrganizationMembers(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get organization members, got error: %s", err))
+			return
+		}
+
+		for _, membership := range memberships {
+			currentUserIds = append(currentUserIds, membership.UserId)
+		}
+	} else {
+		var state OrganizationMembersModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		for _, member := range state.Members {
+			currentUserIds = append(currentUserIds, member.UserId.ValueString())
+		}
+	}
+
+	removed := organizationMembersToRemove(currentUserIds, &plan)
+	threshold := plan.RemovalWarningThreshold.ValueInt64()
+	if int64(len(removed)) > threshold {
+		resp.Diagnostics.AddWarning(
+			"Many organization members will be removed",
+			fmt.Sprintf("Applying this plan removes %d members from the organization, which is more than the removal_warning_threshold of %d. Removed user ids: %s", len(removed), threshold, strings.Join(removed, ", ")),
+		)
+	}
+}
+
+func (r *OrganizationMembers) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OrganizationMembersModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := r.client.UpdateOrganizationMembersResource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create organization members resource, got error: %s", err))
+		return
+	}
+
+	mapOrganizationMembersResponseToModel(memberships, &data)
+
+	tflog.Trace(ctx, "created organization members resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMembers) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OrganizationMembersModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := r.client.GetOrganizationMembers(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get organization members resource, got error: %s", err))
+		return
+	}
+
+	mapOrganizationMembersResponseToModel(memberships, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMembers) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data OrganizationMembersModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := r.client.UpdateOrganizationMembersResource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update organization members resource, got error: %s", err))
+		return
+	}
+
+	mapOrganizationMembersResponseToModel(memberships, &data)
+
+	tflog.Trace(ctx, "updated organization members resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OrganizationMembers) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing every member of the organization is never what is intended, so the members are left as they are
+	tflog.Trace(ctx, "deleted organization members resource without removing members")
+}
+
+func (r *OrganizationMembers) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// organizationMembersToRemove returns the user ids that are currently members but not part of the plan,
+// excluding protected users.
+func organizationMembersToRemove(currentUserIds []string, plan *OrganizationMembersModel) []string {
+	protectedUserIds := organizationMembersProtectedUserIds(plan)
+
+	desired := make(map[string]bool, len(plan.Members))
+	for _, member := range plan.Members {
+		desired[member.UserId.ValueString()] = true
+	}
+
+	var removed []string
+	for _, userId := range currentUserIds {
+		if !desired[userId] && !slices.Contains(protectedUserIds, userId) {
+			removed = append(removed, userId)
+		}
+	}
+
+	return removed
+}
+
+func organizationMembersProtectedUserIds(data *OrganizationMembersModel) []string {
+	protectedUserIds := ListToStringArray(data.ProtectedUserIds)
+	if protectedUserIds == nil {
+		return nil
+	}
+	return *protectedUserIds
+}
+
+// mapOrganizationMembersResponseToModel maps all memberships of the organization to the model. Protected
+// users that are not declared as members are left out, so they do not show up as drift.
+func mapOrganizationMembersResponseToModel(memberships []organizationMembershipResponse, data *OrganizationMembersModel) {
+	protectedUserIds := organizationMembersProtectedUserIds(data)
+
+	declared := make(map[string]bool, len(data.Members))
+	for _, member := range data.Members {
+		declared[member.UserId.ValueString()] = true
+	}
+
+	data.Id = types.StringValue(organizationMembersId)
+	data.Members = make([]OrganizationMembersMemberModel, 0, len(memberships))
+	for _, membership := range memberships {
+		if slices.Contains(protectedUserIds, membership.UserId) && !declared[membership.UserId] {
+			continue
+		}
+
+		data.Members = append(data.Members, OrganizationMembersMemberModel{
+			UserId: types.StringValue(membership.UserId),
+			Role:   types.StringValue(membership.Role),
+		})
+	}
+}
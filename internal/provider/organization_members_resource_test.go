@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The example of allquiet_organization_members removes every non-owner from the organization,
+// which would break tests running in parallel. That is why there is no example test and this
+// test protects all existing members.
+func TestAccOrganizationMembersResource(t *testing.T) {
+	email := fmt.Sprintf("acceptance-tests+%s@allquiet.app", uuid.New().String())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrganizationMembersResourceConfig(email, "Member"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_organization_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("allquiet_organization_members.test", "members.0.role", "Member"),
+					resource.TestCheckResourceAttrPair("allquiet_organization_members.test", "members.0.user_id", "allquiet_user.test", "id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrganizationMembersResourceConfig(email, "Administrator"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_organization_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("allquiet_organization_members.test", "members.0.role", "Administrator"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccOrganizationMembersResourceConfig(email string, role string) string {
+	return fmt.Sprintf(`
+data "allquiet_organization_memberships" "existing" {}
+
+resource "allquiet_user" "test" {
+  display_name = "Organization Members Test"
+  email        = %[1]q
+}
+
+resource "allquiet_organization_members" "test" {
+  members = [
+    {
+      user_id = allquiet_user.test.id
+      role    = %[2]q
+    }
+  ]
+  protected_user_ids        = [for membership in data.allquiet_organization_memberships.existing.organization_memberships : membership.user_id if membership.user_id != allquiet_user.test.id]
+  removal_warning_threshold = 0
+}
+`, email, role)
+}
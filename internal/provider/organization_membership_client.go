@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 )
@@ -109,3 +110,35 @@ func (c *AllQuietAPIClient) GetOrganizationMembershipResource(ctx context.Contex
 
 	return &result, nil
 }
+
+type organizationMembershipsResponse struct {
+	pagedResponse
+	OrganizationMemberships []organizationMembershipResponse `json:"organizationMemberships"`
+}
+
+// GetOrganizationMemberships lists all organization memberships, optionally filtered by user id and role.
+func (c *AllQuietAPIClient) GetOrganizationMemberships(ctx context.Context, userId *string, role *string) ([]organizationMembershipResponse, error) {
+	url := "/organization-membership/search/list"
+	if userId != nil {
+		url = AddQueryParam(url, "userId", *userId)
+	}
+	if role != nil {
+		url = AddQueryParam(url, "role", *role)
+	}
+
+	var result []organizationMembershipResponse
+	_, err := c.getAllPages(ctx, url, func(body io.Reader) (*string, error) {
+		var page organizationMembershipsResponse
+		if err := json.NewDecoder(body).Decode(&page); err != nil {
+			return nil, err
+		}
+
+		result = append(result, page.OrganizationMemberships...)
+		return page.ContinuationToken, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &OrganizationMembershipsDataSource{}
+
+func NewOrganizationMembershipsDataSource() datasource.DataSource {
+	return &OrganizationMembershipsDataSource{}
+}
+
+// OrganizationMembershipsDataSource defines the data source implementation.
+type OrganizationMembershipsDataSource struct {
+	client *AllQuietAPIClient
+}
+
+// OrganizationMembershipsDataSourceModel describes the data source data model.
+type OrganizationMembershipsDataSourceModel struct {
+	UserId                  types.String                            `tfsdk:"user_id"`
+	Role                    types.String                            `tfsdk:"role"`
+	OrganizationMemberships []OrganizationMembershipDataSourceModel `tfsdk:"organization_memberships"`
+}
+
+type OrganizationMembershipDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	UserId    types.String `tfsdk:"user_id"`
+	Role      types.String `tfsdk:"role"`
+	UserEmail types.String `tfsdk:"user_email"`
+}
+
+func (d *OrganizationMembershipsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_memberships"
+}
+
+func (d *OrganizationMembershipsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Organization memberships data source",
+		Attributes: map[string]schema.Attribute{
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user to filter by",
+				Optional:            true,
+			},
+			"role": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Role of the user in the organization to filter by. Possible values are: " + strings.Join(ValidOrganizationMembershipRoles, ", "),
+				Validators:          []validator.String{stringvalidator.OneOf(ValidOrganizationMembershipRoles...)},
+			},
+			"organization_memberships": schema.ListNestedAttribute{
+				MarkdownDescription: "List of organization memberships",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Organization membership ID",
+							Computed:            true,
+						},
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "User ID",
+							Computed:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role of the user in the organization",
+							Computed:            true,
+						},
+						"user_email": schema.StringAttribute{
+							MarkdownDescription: "Email address of the user",
+							Computed:            true,
+							Sensitive:           true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *OrganizationMembershipsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *OrganizationMembershipsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data OrganizationMembershipsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationMembershipsResponse, err := d.client.GetOrganizationMembershipsDataSource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get organization memberships, got error: %s", err))
+		return
+	}
+
+	mapOrganizationMembershipsResponseToDataSourceModel(organizationMembershipsResponse, &data)
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func mapOrganizationMembershipsResponseToDataSourceModel(organizationMembershipsResponse []organizationMembershipDataSourceResponse, data *OrganizationMembershipsDataSourceModel) {
+	data.OrganizationMemberships = make([]OrganizationMembershipDataSourceModel, 0, len(organizationMembershipsResponse))
+	for _, organizationMembership := range organizationMembershipsResponse {
+		data.OrganizationMemberships = append(data.OrganizationMemberships, OrganizationMembershipDataSourceModel{
+			Id:        types.StringValue(organizationMembership.Id),
+			UserId:    types.StringValue(organizationMembership.UserId),
+			Role:      types.StringValue(organizationMembership.Role),
+			UserEmail: types.StringValue(organizationMembership.UserEmail),
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"context"
+)
+
+type organizationMembershipDataSourceResponse struct {
+	organizationMembershipResponse
+	UserEmail string
+}
+
+// GetOrganizationMembershipsDataSource lists the organization memberships matching the data source filters
+// and joins the email address of each member from the user list.
+func (c *AllQuietAPIClient) GetOrganizationMembershipsDataSource(ctx context.Context, data *OrganizationMembershipsDataSourceModel) ([]organizationMembershipDataSourceResponse, error) {
+	organizationMemberships, err := c.GetOrganizationMemberships(ctx, data.UserId.ValueStringPointer(), data.Role.ValueStringPointer())
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := c.GetUsersDataSource(ctx, &UsersDataSourceModel{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	emailsByUserId := make(map[string]string, len(users.Users))
+	for _, user := range users.Users {
+		emailsByUserId[user.Id] = user.Email
+	}
+
+	result := make([]organizationMembershipDataSourceResponse, 0, len(organizationMemberships))
+	for _, organizationMembership := range organizationMemberships {
+		// Filter client-side as well, so the result is correct even if the API ignores a filter
+		if data.UserId.ValueString() != "" && organizationMembership.UserId != data.UserId.ValueString() {
+			continue
+		}
+		if data.Role.ValueString() != "" && organizationMembership.Role != data.Role.ValueString() {
+			continue
+		}
+
+		result = append(result, organizationMembershipDataSourceResponse{
+			organizationMembershipResponse: organizationMembership,
+			UserEmail:                      emailsByUserId[organizationMembership.UserId],
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrganizationMembershipsDataSource(t *testing.T) {
+	uid := uuid.New().String()
+	email := fmt.Sprintf("acceptance-tests+taylor+%s@allquiet.app", uid)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationMembershipsDataSourceConfig(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.allquiet_organization_memberships.by_user", "organization_memberships.#", "1"),
+					resource.TestCheckResourceAttr("data.allquiet_organization_memberships.by_user", "organization_memberships.0.role", "Administrator"),
+					resource.TestCheckResourceAttr("data.allquiet_organization_memberships.by_user", "organization_memberships.0.user_email", email),
+					resource.TestCheckResourceAttrPair("data.allquiet_organization_memberships.by_user", "organization_memberships.0.user_id", "allquiet_user.test", "id"),
+					resource.TestCheckResourceAttrSet("data.allquiet_organization_memberships.administrators", "organization_memberships.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationMembershipsDataSourceConfig(email string) string {
+	return fmt.Sprintf(`
+		resource "allquiet_user" "test" {
+			display_name = "Taylor Swift"
+			email        = %[1]q
+		}
+
+		resource "allquiet_organization_membership" "test" {
+			user_id = allquiet_user.test.id
+			role    = "Administrator"
+		}
+
+		data "allquiet_organization_memberships" "by_user" {
+			user_id    = allquiet_user.test.id
+			depends_on = [allquiet_organization_membership.test]
+		}
+
+		data "allquiet_organization_memberships" "administrators" {
+			role       = "Administrator"
+			depends_on = [allquiet_organization_membership.test]
+		}
+	`, email)
+}
+
+func TestAccOrganizationMembershipsDataSourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOrganizationMembershipsDataSourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.allquiet_organization_memberships.owners", "organization_memberships.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrganizationMembershipsDataSourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/data-sources/allquiet_organization_memberships/data-source.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(dat)
+}
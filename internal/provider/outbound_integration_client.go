@@ -16,19 +16,25 @@ type outboundIntegrationResponse struct {
 	TriggersOnlyOnForwarded     *bool
 	SkipUpdatingAfterForwarding *bool
 	TeamConnectionSettings      *teamConnectionSettings
-	SlackSettings               *slackSettings      `json:"slackSettings"`
-	MattermostSettings          *mattermostSettings `json:"mattermostSettings"`
+	SlackSettings               *slackSettings           `json:"slackSettings"`
+	MattermostSettings          *mattermostSettings      `json:"mattermostSettings"`
+	MsTeamsSettings             *msTeamsSettings         `json:"msTeamsSettings"`
+	WebhookSettings             *outboundWebhookSettings `json:"webhookSettings"`
+	TicketingSettings           *ticketingSettings       `json:"ticketingSettings"`
 }
 
 type outboundIntegrationCreateRequest struct {
-	DisplayName                 string                  `json:"displayName"`
-	TeamId                      string                  `json:"teamId"`
-	Type                        string                  `json:"type"`
-	TriggersOnlyOnForwarded     *bool                   `json:"triggersOnlyOnForwarded"`
-	SkipUpdatingAfterForwarding *bool                   `json:"skipUpdatingAfterForwarding"`
-	TeamConnectionSettings      *teamConnectionSettings `json:"teamConnectionSettings"`
-	SlackSettings               *slackSettings          `json:"slackSettings"`
-	MattermostSettings          *mattermostSettings     `json:"mattermostSettings"`
+	DisplayName                 string                   `json:"displayName"`
+	TeamId                      string                   `json:"teamId"`
+	Type                        string                   `json:"type"`
+	TriggersOnlyOnForwarded     *bool                    `json:"triggersOnlyOnForwarded"`
+	SkipUpdatingAfterForwarding *bool                    `json:"skipUpdatingAfterForwarding"`
+	TeamConnectionSettings      *teamConnectionSettings  `json:"teamConnectionSettings"`
+	SlackSettings               *slackSettings           `json:"slackSettings"`
+	MattermostSettings          *mattermostSettings      `json:"mattermostSettings"`
+	MsTeamsSettings             *msTeamsSettings         `json:"msTeamsSettings"`
+	WebhookSettings             *outboundWebhookSettings `json:"webhookSettings"`
+	TicketingSettings           *ticketingSettings       `json:"ticketingSettings"`
 }
 
 func mapOutboundIntegrationCreateRequest(plan *OutboundIntegrationModel) *outboundIntegrationCreateRequest {
@@ -41,6 +47,9 @@ func mapOutboundIntegrationCreateRequest(plan *OutboundIntegrationModel) *outbou
 		TeamConnectionSettings:      MapTeamConnectionSettingsToRequest(plan.TeamConnectionSettings),
 		SlackSettings:               MapSlackSettingsToRequest(plan.SlackSettings),
 		MattermostSettings:          MapMattermostSettingsToRequest(plan.MattermostSettings),
+		MsTeamsSettings:             MapMsTeamsSettingsToRequest(plan.MsTeamsSettings),
+		WebhookSettings:             MapOutboundWebhookSettingsToRequest(plan.WebhookSettings),
+		TicketingSettings:           MapTicketingSettingsToRequest(plan.TicketingSettings),
 	}
 }
 func (c *AllQuietAPIClient) CreateOutboundIntegrationResource(ctx context.Context, data *OutboundIntegrationModel) (*outboundIntegrationResponse, error) {
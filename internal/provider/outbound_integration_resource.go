@@ -9,19 +9,25 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &OutboundIntegration{}
 var _ resource.ResourceWithImportState = &OutboundIntegration{}
+var _ resource.ResourceWithValidateConfig = &OutboundIntegration{}
 
 func NewOutboundIntegration() resource.Resource {
 	return &OutboundIntegration{}
@@ -32,15 +38,18 @@ type OutboundIntegration struct {
 }
 
 type OutboundIntegrationModel struct {
-	Id                          types.String            `tfsdk:"id"`
-	DisplayName                 types.String            `tfsdk:"display_name"`
-	TeamId                      types.String            `tfsdk:"team_id"`
-	Type                        types.String            `tfsdk:"type"`
-	TriggersOnlyOnForwarded     types.Bool              `tfsdk:"triggers_only_on_forwarded"`
-	SkipUpdatingAfterForwarding types.Bool              `tfsdk:"skip_updating_after_forwarding"`
-	TeamConnectionSettings      *TeamConnectionSettings `tfsdk:"team_connection_settings"`
-	SlackSettings               *SlackSettings          `tfsdk:"slack_settings"`
-	MattermostSettings          *MattermostSettings     `tfsdk:"mattermost_settings"`
+	Id                          types.String             `tfsdk:"id"`
+	DisplayName                 types.String             `tfsdk:"display_name"`
+	TeamId                      types.String             `tfsdk:"team_id"`
+	Type                        types.String             `tfsdk:"type"`
+	TriggersOnlyOnForwarded     types.Bool               `tfsdk:"triggers_only_on_forwarded"`
+	SkipUpdatingAfterForwarding types.Bool               `tfsdk:"skip_updating_after_forwarding"`
+	TeamConnectionSettings      *TeamConnectionSettings  `tfsdk:"team_connection_settings"`
+	SlackSettings               *SlackSettings           `tfsdk:"slack_settings"`
+	MattermostSettings          *MattermostSettings      `tfsdk:"mattermost_settings"`
+	MsTeamsSettings             *MsTeamsSettings         `tfsdk:"ms_teams_settings"`
+	WebhookSettings             *OutboundWebhookSettings `tfsdk:"webhook_settings"`
+	TicketingSettings           *TicketingSettings       `tfsdk:"ticketing_settings"`
 }
 
 func (r *OutboundIntegration) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -68,7 +77,7 @@ func (r *OutboundIntegration) Schema(ctx context.Context, req resource.SchemaReq
 				Required:            true,
 			},
 			"type": schema.StringAttribute{
-				MarkdownDescription: "The type of the integration. See all types here: https://allquiet.app/api/public/v1/outbound-integration/types",
+				MarkdownDescription: "The type of the integration. See all types here: https://allquiet.app/api/public/v1/outbound-integration/types or use the `allquiet_outbound_integration_types` data source",
 				Required:            true,
 			},
 			"triggers_only_on_forwarded": schema.BoolAttribute{
@@ -222,10 +231,266 @@ func (r *OutboundIntegration) Schema(ctx context.Context, req resource.SchemaReq
 					},
 				},
 			},
+			"ms_teams_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Microsoft Teams-specific settings for the integration. Only applicable when type is 'MicrosoftTeams'.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"selected_team_id": schema.StringAttribute{
+						MarkdownDescription: "The Microsoft Teams team ID the channels belong to.",
+						Optional:            true,
+					},
+					"selected_channel_ids": schema.ListAttribute{
+						MarkdownDescription: "List of Microsoft Teams channel IDs to send notifications to. Either this or severity_based_channel_settings must be provided, but not both.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("severity_based_channel_settings")),
+						},
+					},
+					"severity_based_channel_settings": schema.SingleNestedAttribute{
+						MarkdownDescription: "Severity-based channel settings. Either this or selected_channel_ids must be provided, but not both.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"selected_channel_ids_minor": schema.ListAttribute{
+								MarkdownDescription: "List of Microsoft Teams channel IDs for minor severity notifications.",
+								Optional:            true,
+								ElementType:         types.StringType,
+							},
+							"selected_channel_ids_warning": schema.ListAttribute{
+								MarkdownDescription: "List of Microsoft Teams channel IDs for warning severity notifications.",
+								Optional:            true,
+								ElementType:         types.StringType,
+							},
+							"selected_channel_ids_critical": schema.ListAttribute{
+								MarkdownDescription: "List of Microsoft Teams channel IDs for critical severity notifications.",
+								Optional:            true,
+								ElementType:         types.StringType,
+							},
+						},
+					},
+					"on_call_reminder_schedule_settings": schema.SingleNestedAttribute{
+						MarkdownDescription: "Schedule settings for on-call reminders.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"run_time": schema.StringAttribute{
+								MarkdownDescription: "The time to run the reminder in HH:mm format (e.g., '09:00').",
+								Optional:            true,
+								Validators:          []validator.String{TimeValidator("Not a valid time")},
+							},
+							"days_of_week": schema.ListAttribute{
+								MarkdownDescription: "List of days of the week for the reminder schedule. Possible values are: " + strings.Join(ValidDaysOfWeek, ", "),
+								Optional:            true,
+								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.ValueStringsAre(DaysOfWeekValidator("Not a valid day of week")),
+								},
+							},
+						},
+					},
+					"on_call_reminder_channel_ids": schema.ListAttribute{
+						MarkdownDescription: "List of Microsoft Teams channel IDs for on-call reminders.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"tag_on_call_members": schema.BoolAttribute{
+						MarkdownDescription: "If true, tag on-call members in Microsoft Teams notifications.",
+						Optional:            true,
+					},
+				},
+			},
+			"webhook_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Webhook-specific settings for the integration. Only applicable when type is 'Webhook'.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "The URL the webhook is sent to.",
+						Required:            true,
+					},
+					"method": schema.StringAttribute{
+						MarkdownDescription: "The HTTP method of the webhook. Possible values are: " + strings.Join(ValidOutboundWebhookMethods, ", ") + ". Defaults to POST.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("POST"),
+						Validators:          []validator.String{OutboundWebhookMethodValidator("Not a valid method")},
+					},
+					"headers": schema.MapAttribute{
+						MarkdownDescription: "Custom headers sent with the webhook.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"authentication": schema.SingleNestedAttribute{
+						MarkdownDescription: "The authentication of the webhook.",
+						Optional:            true,
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								MarkdownDescription: "The type of the authentication. Possible values are: " + strings.Join(ValidOutboundWebhookAuthenticationTypes, ", "),
+								Required:            true,
+								Validators:          []validator.String{OutboundWebhookAuthenticationTypeValidator("Not a valid authentication type")},
+							},
+							"username": schema.StringAttribute{
+								MarkdownDescription: "The username for Basic authentication.",
+								Optional:            true,
+							},
+							"password": schema.StringAttribute{
+								MarkdownDescription: "The password for Basic authentication. Write-only, it is not stored in the state and requires Terraform 1.11 or later.",
+								Optional:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
+							},
+							"token": schema.StringAttribute{
+								MarkdownDescription: "The token for Bearer authentication. Write-only, it is not stored in the state and requires Terraform 1.11 or later.",
+								Optional:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
+							},
+							"hmac_secret": schema.StringAttribute{
+								MarkdownDescription: "The secret the payload is signed with (HMAC-SHA256) for HmacSignature authentication. Write-only, it is not stored in the state and requires Terraform 1.11 or later.",
+								Optional:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
+							},
+							"secret_version": schema.Int64Attribute{
+								MarkdownDescription: "Changing only the write-only `password`, `token` or `hmac_secret` does not update the outbound integration, as write-only values are not part of the plan. They are sent to All Quiet with every create and update, so change the version to send new secrets right away.",
+								Optional:            true,
+							},
+							"hmac_header_name": schema.StringAttribute{
+								MarkdownDescription: "The header the signature is sent in for HmacSignature authentication. Defaults to X-AllQuiet-Signature.",
+								Optional:            true,
+							},
+						},
+					},
+					"payload_template": schema.StringAttribute{
+						MarkdownDescription: "The body of the webhook as a [Go template](https://pkg.go.dev/text/template) rendered against the incident, e.g. `{\"text\": {{ json .title }}}`. " +
+							"Besides the builtin functions, `json`, `upper` and `lower` are available. Use the `render_webhook_payload` function to test a template. If not set, the incident is sent as JSON.",
+						Optional:   true,
+						Validators: []validator.String{PayloadTemplateValidator("Not a valid payload template")},
+					},
+				},
+			},
+			"ticketing_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Issue tracker-specific settings for the integration, e.g. for Jira or ServiceNow. Only applicable to ticketing integration types.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"base_url": schema.StringAttribute{
+						MarkdownDescription: "The base URL of the issue tracker, e.g. https://example.atlassian.net",
+						Required:            true,
+					},
+					"project_key": schema.StringAttribute{
+						MarkdownDescription: "The key of the project tickets are created in.",
+						Required:            true,
+					},
+					"issue_type": schema.StringAttribute{
+						MarkdownDescription: "The issue type of created tickets, e.g. 'Incident'. If not set, the default issue type of the project is used.",
+						Optional:            true,
+					},
+					"priority_mapping": schema.MapAttribute{
+						MarkdownDescription: "Maps incident severities to ticket priorities, e.g. `{ Critical = \"Highest\" }`. Possible keys are: " + strings.Join(ValidSeverities, ", "),
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(SeverityValidator("Not a valid severity")),
+						},
+					},
+					"field_mappings": schema.MapAttribute{
+						MarkdownDescription: "Maps ticket fields to incident attributes. The key is the name of the ticket field, the value the name of the incident attribute written to it.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"credentials": schema.SingleNestedAttribute{
+						MarkdownDescription: "The credentials used to access the issue tracker.",
+						Required:            true,
+						Attributes: map[string]schema.Attribute{
+							"username": schema.StringAttribute{
+								MarkdownDescription: "The username or email of the account tickets are created with.",
+								Optional:            true,
+							},
+							"api_token": schema.StringAttribute{
+								MarkdownDescription: "The API token of the account. It is write-only and never stored in the state. Requires Terraform 1.11 or later.",
+								Required:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
+							},
+							"secret_version": schema.Int64Attribute{
+								MarkdownDescription: "Changing only the write-only `api_token` does not update the outbound integration, as write-only values are not part of the plan. The token is sent to All Quiet with every create and update, so change the version to send a new token right away.",
+								Optional:            true,
+							},
+						},
+					},
+					"create_ticket_intents": schema.ListAttribute{
+						MarkdownDescription: "The intents on which a ticket is created. Possible values are: " + strings.Join(ValidIntents, ", "),
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(IntentValidator("Not a valid intent")),
+						},
+					},
+					"comment_intents": schema.ListAttribute{
+						MarkdownDescription: "The intents on which a comment is added to the ticket. Possible values are: " + strings.Join(ValidIntents, ", "),
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(IntentValidator("Not a valid intent")),
+						},
+					},
+					"close_ticket_intents": schema.ListAttribute{
+						MarkdownDescription: "The intents on which the ticket is closed. Possible values are: " + strings.Join(ValidIntents, ", "),
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(IntentValidator("Not a valid intent")),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *OutboundIntegration) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var webhookSettings types.Object
+	var authenticationObject types.Object
+	authenticationPath := path.Root("webhook_settings").AtName("authentication")
+
+	// The authentication is read on its own, as other nested objects may still be unknown.
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("webhook_settings"), &webhookSettings)...)
+	if resp.Diagnostics.HasError() || webhookSettings.IsNull() || webhookSettings.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, authenticationPath, &authenticationObject)...)
+	if resp.Diagnostics.HasError() || authenticationObject.IsNull() || authenticationObject.IsUnknown() {
+		return
+	}
+
+	var authentication OutboundWebhookAuthentication
+	resp.Diagnostics.Append(authenticationObject.As(ctx, &authentication, basetypes.ObjectAsOptions{})...)
+	if resp.Diagnostics.HasError() || authentication.Type.IsUnknown() {
+		return
+	}
+
+	required := map[string][]string{
+		"Basic":         {"username", "password"},
+		"Bearer":        {"token"},
+		"HmacSignature": {"hmac_secret"},
+	}
+	values := map[string]types.String{
+		"username":    authentication.Username,
+		"password":    authentication.Password,
+		"token":       authentication.Token,
+		"hmac_secret": authentication.HmacSecret,
+	}
+
+	for _, name := range required[authentication.Type.ValueString()] {
+		if values[name].IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				authenticationPath.AtName(name),
+				"Missing Required Attribute",
+				fmt.Sprintf("When the authentication type is '%s', %s must be specified", authentication.Type.ValueString(), name),
+			)
+		}
+	}
+}
+
 func (r *OutboundIntegration) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -253,6 +518,12 @@ func (r *OutboundIntegration) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	// Write-only secrets are only available in the config
+	resp.Diagnostics.Append(applyOutboundIntegrationWriteOnlySecrets(ctx, req.Config, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	integrationResponse, err := r.client.CreateOutboundIntegrationResource(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration resource, got error: %s", err))
@@ -300,6 +571,12 @@ func (r *OutboundIntegration) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	// Write-only secrets are only available in the config
+	resp.Diagnostics.Append(applyOutboundIntegrationWriteOnlySecrets(ctx, req.Config, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	integrationResponse, err := r.client.UpdateOutboundIntegrationResource(ctx, data.Id.ValueString(), &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration resource, got error: %s", err))
@@ -355,6 +632,9 @@ func mapOutboundIntegrationResponseToModel(ctx context.Context, response *outbou
 
 	data.SlackSettings = MapSlackSettingsResponseToModel(ctx, response.SlackSettings)
 	data.MattermostSettings = MapMattermostSettingsResponseToModel(ctx, response.MattermostSettings)
+	data.MsTeamsSettings = MapMsTeamsSettingsResponseToModel(ctx, response.MsTeamsSettings)
+	data.WebhookSettings = MapOutboundWebhookSettingsResponseToModel(response.WebhookSettings, data.WebhookSettings)
+	data.TicketingSettings = MapTicketingSettingsResponseToModel(ctx, response.TicketingSettings, data.TicketingSettings)
 }
 
 type SlackSettings struct {
@@ -389,6 +669,51 @@ type MattermostSettings struct {
 	IsMessageReadOnly             types.Bool                              `tfsdk:"is_message_read_only"`
 }
 
+type MsTeamsSettings struct {
+	SelectedTeamId                 types.String                  `tfsdk:"selected_team_id"`
+	SelectedChannelIds             types.List                    `tfsdk:"selected_channel_ids"`
+	SeverityBasedChannelSettings   *SeverityBasedChannelSettings `tfsdk:"severity_based_channel_settings"`
+	OnCallReminderScheduleSettings *ReminderScheduleSettings     `tfsdk:"on_call_reminder_schedule_settings"`
+	OnCallReminderChannelIds       types.List                    `tfsdk:"on_call_reminder_channel_ids"`
+	TagOnCallMembers               types.Bool                    `tfsdk:"tag_on_call_members"`
+}
+
+type OutboundWebhookSettings struct {
+	Url             types.String                   `tfsdk:"url"`
+	Method          types.String                   `tfsdk:"method"`
+	Headers         types.Map                      `tfsdk:"headers"`
+	Authentication  *OutboundWebhookAuthentication `tfsdk:"authentication"`
+	PayloadTemplate types.String                   `tfsdk:"payload_template"`
+}
+
+type OutboundWebhookAuthentication struct {
+	Type           types.String `tfsdk:"type"`
+	Username       types.String `tfsdk:"username"`
+	Password       types.String `tfsdk:"password"`
+	Token          types.String `tfsdk:"token"`
+	HmacSecret     types.String `tfsdk:"hmac_secret"`
+	HmacHeaderName types.String `tfsdk:"hmac_header_name"`
+	SecretVersion  types.Int64  `tfsdk:"secret_version"`
+}
+
+type TicketingSettings struct {
+	BaseUrl             types.String          `tfsdk:"base_url"`
+	ProjectKey          types.String          `tfsdk:"project_key"`
+	IssueType           types.String          `tfsdk:"issue_type"`
+	PriorityMapping     types.Map             `tfsdk:"priority_mapping"`
+	FieldMappings       types.Map             `tfsdk:"field_mappings"`
+	Credentials         *TicketingCredentials `tfsdk:"credentials"`
+	CreateTicketIntents types.List            `tfsdk:"create_ticket_intents"`
+	CommentIntents      types.List            `tfsdk:"comment_intents"`
+	CloseTicketIntents  types.List            `tfsdk:"close_ticket_intents"`
+}
+
+type TicketingCredentials struct {
+	Username      types.String `tfsdk:"username"`
+	ApiToken      types.String `tfsdk:"api_token"`
+	SecretVersion types.Int64  `tfsdk:"secret_version"`
+}
+
 type MattermostSeverityBasedChannelSettings struct {
 	SelectedChannelIdsMinor    types.List `tfsdk:"selected_channel_ids_minor"`
 	SelectedChannelIdsWarning  types.List `tfsdk:"selected_channel_ids_warning"`
@@ -427,6 +752,51 @@ type mattermostSettings struct {
 	IsMessageReadOnly             *bool                                   `json:"isMessageReadOnly"`
 }
 
+type msTeamsSettings struct {
+	SelectedTeamId                 *string                       `json:"selectedTeamId"`
+	SelectedChannelIds             *[]string                     `json:"selectedChannelIds"`
+	SeverityBasedChannelSettings   *severityBasedChannelSettings `json:"severityBasedChannelSettings"`
+	OnCallReminderScheduleSettings *reminderScheduleSettings     `json:"onCallReminderScheduleSettings"`
+	OnCallReminderChannelIds       *[]string                     `json:"onCallReminderChannelIds"`
+	TagOnCallMembers               *bool                         `json:"tagOnCallMembers"`
+}
+
+type outboundWebhookSettings struct {
+	Url             string                         `json:"url"`
+	Method          *string                        `json:"method"`
+	Headers         *map[string]string             `json:"headers"`
+	Authentication  *outboundWebhookAuthentication `json:"authentication"`
+	PayloadTemplate *string                        `json:"payloadTemplate"`
+}
+
+// outboundWebhookAuthentication is sent with its secrets, which the API never returns.
+type outboundWebhookAuthentication struct {
+	Type           string  `json:"type"`
+	Username       *string `json:"username"`
+	Password       *string `json:"password,omitempty"`
+	Token          *string `json:"token,omitempty"`
+	HmacSecret     *string `json:"hmacSecret,omitempty"`
+	HmacHeaderName *string `json:"hmacHeaderName"`
+}
+
+type ticketingSettings struct {
+	BaseUrl             string                `json:"baseUrl"`
+	ProjectKey          string                `json:"projectKey"`
+	IssueType           *string               `json:"issueType"`
+	PriorityMapping     *map[string]string    `json:"priorityMapping"`
+	FieldMappings       *map[string]string    `json:"fieldMappings"`
+	Credentials         *ticketingCredentials `json:"credentials"`
+	CreateTicketIntents *[]string             `json:"createTicketIntents"`
+	CommentIntents      *[]string             `json:"commentIntents"`
+	CloseTicketIntents  *[]string             `json:"closeTicketIntents"`
+}
+
+// ticketingCredentials is sent with its api token, which the API never returns.
+type ticketingCredentials struct {
+	Username *string `json:"username"`
+	ApiToken *string `json:"apiToken,omitempty"`
+}
+
 type mattermostSeverityBasedChannelSettings struct {
 	SelectedChannelIdsMinor    *[]string `json:"selectedChannelIdsMinor"`
 	SelectedChannelIdsWarning  *[]string `json:"selectedChannelIdsWarning"`
@@ -438,36 +808,112 @@ func MapSlackSettingsToRequest(settings *SlackSettings) *slackSettings {
 		return nil
 	}
 
-	result := &slackSettings{
-		SelectedChannelIds:            ListToStringArray(settings.SelectedChannelIds),
-		OnCallReminderChannelIds:      ListToStringArray(settings.OnCallReminderChannelIds),
-		TagOnCallMembers:              settings.TagOnCallMembers.ValueBoolPointer(),
-		IsSlackMessagePayloadReadOnly: settings.IsSlackMessagePayloadReadOnly.ValueBoolPointer(),
+	return &slackSettings{
+		SelectedChannelIds:             ListToStringArray(settings.SelectedChannelIds),
+		SeverityBasedChannelSettings:   mapSeverityBasedChannelSettingsToRequest(settings.SeverityBasedChannelSettings),
+		OnCallReminderScheduleSettings: mapReminderScheduleSettingsToRequest(settings.OnCallReminderScheduleSettings),
+		OnCallReminderChannelIds:       ListToStringArray(settings.OnCallReminderChannelIds),
+		TagOnCallMembers:               settings.TagOnCallMembers.ValueBoolPointer(),
+		IsSlackMessagePayloadReadOnly:  settings.IsSlackMessagePayloadReadOnly.ValueBoolPointer(),
 	}
+}
 
-	if settings.SeverityBasedChannelSettings != nil {
-		result.SeverityBasedChannelSettings = &severityBasedChannelSettings{
-			SelectedChannelIdsMinor:    ListToStringArray(settings.SeverityBasedChannelSettings.SelectedChannelIdsMinor),
-			SelectedChannelIdsWarning:  ListToStringArray(settings.SeverityBasedChannelSettings.SelectedChannelIdsWarning),
-			SelectedChannelIdsCritical: ListToStringArray(settings.SeverityBasedChannelSettings.SelectedChannelIdsCritical),
-		}
+func MapMsTeamsSettingsToRequest(settings *MsTeamsSettings) *msTeamsSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &msTeamsSettings{
+		SelectedTeamId:                 settings.SelectedTeamId.ValueStringPointer(),
+		SelectedChannelIds:             ListToStringArray(settings.SelectedChannelIds),
+		SeverityBasedChannelSettings:   mapSeverityBasedChannelSettingsToRequest(settings.SeverityBasedChannelSettings),
+		OnCallReminderScheduleSettings: mapReminderScheduleSettingsToRequest(settings.OnCallReminderScheduleSettings),
+		OnCallReminderChannelIds:       ListToStringArray(settings.OnCallReminderChannelIds),
+		TagOnCallMembers:               settings.TagOnCallMembers.ValueBoolPointer(),
+	}
+}
+
+func MapOutboundWebhookSettingsToRequest(settings *OutboundWebhookSettings) *outboundWebhookSettings {
+	if settings == nil {
+		return nil
+	}
+
+	result := &outboundWebhookSettings{
+		Url:             settings.Url.ValueString(),
+		Method:          settings.Method.ValueStringPointer(),
+		Headers:         MapToStringMap(settings.Headers),
+		PayloadTemplate: settings.PayloadTemplate.ValueStringPointer(),
 	}
 
-	if settings.OnCallReminderScheduleSettings != nil {
-		var runTime *string
-		if !settings.OnCallReminderScheduleSettings.RunTime.IsNull() && !settings.OnCallReminderScheduleSettings.RunTime.IsUnknown() {
-			runTimeStr := settings.OnCallReminderScheduleSettings.RunTime.ValueString()
-			runTime = &runTimeStr
+	if settings.Authentication != nil {
+		result.Authentication = &outboundWebhookAuthentication{
+			Type:           settings.Authentication.Type.ValueString(),
+			Username:       settings.Authentication.Username.ValueStringPointer(),
+			Password:       settings.Authentication.Password.ValueStringPointer(),
+			Token:          settings.Authentication.Token.ValueStringPointer(),
+			HmacSecret:     settings.Authentication.HmacSecret.ValueStringPointer(),
+			HmacHeaderName: settings.Authentication.HmacHeaderName.ValueStringPointer(),
 		}
-		result.OnCallReminderScheduleSettings = &reminderScheduleSettings{
-			RunTime:    runTime,
-			DaysOfWeek: ListToStringArray(settings.OnCallReminderScheduleSettings.DaysOfWeek),
+	}
+
+	return result
+}
+
+func MapTicketingSettingsToRequest(settings *TicketingSettings) *ticketingSettings {
+	if settings == nil {
+		return nil
+	}
+
+	result := &ticketingSettings{
+		BaseUrl:             settings.BaseUrl.ValueString(),
+		ProjectKey:          settings.ProjectKey.ValueString(),
+		IssueType:           settings.IssueType.ValueStringPointer(),
+		PriorityMapping:     MapToStringMap(settings.PriorityMapping),
+		FieldMappings:       MapToStringMap(settings.FieldMappings),
+		CreateTicketIntents: ListToStringArray(settings.CreateTicketIntents),
+		CommentIntents:      ListToStringArray(settings.CommentIntents),
+		CloseTicketIntents:  ListToStringArray(settings.CloseTicketIntents),
+	}
+
+	if settings.Credentials != nil {
+		result.Credentials = &ticketingCredentials{
+			Username: settings.Credentials.Username.ValueStringPointer(),
+			ApiToken: settings.Credentials.ApiToken.ValueStringPointer(),
 		}
 	}
 
 	return result
 }
 
+func mapSeverityBasedChannelSettingsToRequest(settings *SeverityBasedChannelSettings) *severityBasedChannelSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &severityBasedChannelSettings{
+		SelectedChannelIdsMinor:    ListToStringArray(settings.SelectedChannelIdsMinor),
+		SelectedChannelIdsWarning:  ListToStringArray(settings.SelectedChannelIdsWarning),
+		SelectedChannelIdsCritical: ListToStringArray(settings.SelectedChannelIdsCritical),
+	}
+}
+
+func mapReminderScheduleSettingsToRequest(settings *ReminderScheduleSettings) *reminderScheduleSettings {
+	if settings == nil {
+		return nil
+	}
+
+	var runTime *string
+	if !settings.RunTime.IsNull() && !settings.RunTime.IsUnknown() {
+		runTimeStr := settings.RunTime.ValueString()
+		runTime = &runTimeStr
+	}
+
+	return &reminderScheduleSettings{
+		RunTime:    runTime,
+		DaysOfWeek: ListToStringArray(settings.DaysOfWeek),
+	}
+}
+
 func MapMattermostSettingsToRequest(settings *MattermostSettings) *mattermostSettings {
 	if settings == nil {
 		return nil
@@ -560,13 +1006,9 @@ func MapSlackSettingsResponseToModel(ctx context.Context, settings *slackSetting
 	}
 
 	hasSelectedChannelIds := settings.SelectedChannelIds != nil && len(*settings.SelectedChannelIds) > 0
-	hasSeverityBased := settings.SeverityBasedChannelSettings != nil &&
-		((settings.SeverityBasedChannelSettings.SelectedChannelIdsMinor != nil && len(*settings.SeverityBasedChannelSettings.SelectedChannelIdsMinor) > 0) ||
-			(settings.SeverityBasedChannelSettings.SelectedChannelIdsWarning != nil && len(*settings.SeverityBasedChannelSettings.SelectedChannelIdsWarning) > 0) ||
-			(settings.SeverityBasedChannelSettings.SelectedChannelIdsCritical != nil && len(*settings.SeverityBasedChannelSettings.SelectedChannelIdsCritical) > 0))
+	hasSeverityBased := hasSeverityBasedChannels(settings.SeverityBasedChannelSettings)
 	hasOnCallReminderChannelIds := settings.OnCallReminderChannelIds != nil && len(*settings.OnCallReminderChannelIds) > 0
-	hasOnCallReminderSchedule := settings.OnCallReminderScheduleSettings != nil &&
-		(settings.OnCallReminderScheduleSettings.RunTime != nil || (settings.OnCallReminderScheduleSettings.DaysOfWeek != nil && len(*settings.OnCallReminderScheduleSettings.DaysOfWeek) > 0))
+	hasOnCallReminderSchedule := hasReminderSchedule(settings.OnCallReminderScheduleSettings)
 	hasTagOnCallMembers := settings.TagOnCallMembers != nil
 	hasIsSlackMessagePayloadReadOnly := settings.IsSlackMessagePayloadReadOnly != nil
 
@@ -574,28 +1016,165 @@ func MapSlackSettingsResponseToModel(ctx context.Context, settings *slackSetting
 		return nil
 	}
 
-	result := &SlackSettings{
-		SelectedChannelIds:            MapNullableList(ctx, settings.SelectedChannelIds),
-		OnCallReminderChannelIds:      MapNullableList(ctx, settings.OnCallReminderChannelIds),
-		TagOnCallMembers:              types.BoolPointerValue(settings.TagOnCallMembers),
-		IsSlackMessagePayloadReadOnly: types.BoolPointerValue(settings.IsSlackMessagePayloadReadOnly),
+	return &SlackSettings{
+		SelectedChannelIds:             MapNullableList(ctx, settings.SelectedChannelIds),
+		SeverityBasedChannelSettings:   mapSeverityBasedChannelSettingsResponseToModel(ctx, settings.SeverityBasedChannelSettings),
+		OnCallReminderScheduleSettings: mapReminderScheduleSettingsResponseToModel(ctx, settings.OnCallReminderScheduleSettings),
+		OnCallReminderChannelIds:       MapNullableList(ctx, settings.OnCallReminderChannelIds),
+		TagOnCallMembers:               types.BoolPointerValue(settings.TagOnCallMembers),
+		IsSlackMessagePayloadReadOnly:  types.BoolPointerValue(settings.IsSlackMessagePayloadReadOnly),
 	}
+}
 
-	if settings.SeverityBasedChannelSettings != nil {
-		result.SeverityBasedChannelSettings = &SeverityBasedChannelSettings{
-			SelectedChannelIdsMinor:    MapNullableList(ctx, settings.SeverityBasedChannelSettings.SelectedChannelIdsMinor),
-			SelectedChannelIdsWarning:  MapNullableList(ctx, settings.SeverityBasedChannelSettings.SelectedChannelIdsWarning),
-			SelectedChannelIdsCritical: MapNullableList(ctx, settings.SeverityBasedChannelSettings.SelectedChannelIdsCritical),
-		}
+func MapMsTeamsSettingsResponseToModel(ctx context.Context, settings *msTeamsSettings) *MsTeamsSettings {
+	if settings == nil {
+		return nil
 	}
 
-	if settings.OnCallReminderScheduleSettings != nil {
-		runTime := types.StringPointerValue(settings.OnCallReminderScheduleSettings.RunTime)
-		result.OnCallReminderScheduleSettings = &ReminderScheduleSettings{
-			RunTime:    runTime,
-			DaysOfWeek: MapNullableList(ctx, settings.OnCallReminderScheduleSettings.DaysOfWeek),
+	hasSelectedTeamId := settings.SelectedTeamId != nil && *settings.SelectedTeamId != ""
+	hasSelectedChannelIds := settings.SelectedChannelIds != nil && len(*settings.SelectedChannelIds) > 0
+	hasSeverityBased := hasSeverityBasedChannels(settings.SeverityBasedChannelSettings)
+	hasOnCallReminderChannelIds := settings.OnCallReminderChannelIds != nil && len(*settings.OnCallReminderChannelIds) > 0
+	hasOnCallReminderSchedule := hasReminderSchedule(settings.OnCallReminderScheduleSettings)
+	hasTagOnCallMembers := settings.TagOnCallMembers != nil
+
+	if !hasSelectedTeamId && !hasSelectedChannelIds && !hasSeverityBased && !hasOnCallReminderChannelIds && !hasOnCallReminderSchedule && !hasTagOnCallMembers {
+		return nil
+	}
+
+	return &MsTeamsSettings{
+		SelectedTeamId:                 types.StringPointerValue(settings.SelectedTeamId),
+		SelectedChannelIds:             MapNullableList(ctx, settings.SelectedChannelIds),
+		SeverityBasedChannelSettings:   mapSeverityBasedChannelSettingsResponseToModel(ctx, settings.SeverityBasedChannelSettings),
+		OnCallReminderScheduleSettings: mapReminderScheduleSettingsResponseToModel(ctx, settings.OnCallReminderScheduleSettings),
+		OnCallReminderChannelIds:       MapNullableList(ctx, settings.OnCallReminderChannelIds),
+		TagOnCallMembers:               types.BoolPointerValue(settings.TagOnCallMembers),
+	}
+}
+
+func hasSeverityBasedChannels(settings *severityBasedChannelSettings) bool {
+	return settings != nil &&
+		((settings.SelectedChannelIdsMinor != nil && len(*settings.SelectedChannelIdsMinor) > 0) ||
+			(settings.SelectedChannelIdsWarning != nil && len(*settings.SelectedChannelIdsWarning) > 0) ||
+			(settings.SelectedChannelIdsCritical != nil && len(*settings.SelectedChannelIdsCritical) > 0))
+}
+
+func hasReminderSchedule(settings *reminderScheduleSettings) bool {
+	return settings != nil &&
+		(settings.RunTime != nil || (settings.DaysOfWeek != nil && len(*settings.DaysOfWeek) > 0))
+}
+
+func mapSeverityBasedChannelSettingsResponseToModel(ctx context.Context, settings *severityBasedChannelSettings) *SeverityBasedChannelSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &SeverityBasedChannelSettings{
+		SelectedChannelIdsMinor:    MapNullableList(ctx, settings.SelectedChannelIdsMinor),
+		SelectedChannelIdsWarning:  MapNullableList(ctx, settings.SelectedChannelIdsWarning),
+		SelectedChannelIdsCritical: MapNullableList(ctx, settings.SelectedChannelIdsCritical),
+	}
+}
+
+func mapReminderScheduleSettingsResponseToModel(ctx context.Context, settings *reminderScheduleSettings) *ReminderScheduleSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &ReminderScheduleSettings{
+		RunTime:    types.StringPointerValue(settings.RunTime),
+		DaysOfWeek: MapNullableList(ctx, settings.DaysOfWeek),
+	}
+}
+
+// applyOutboundIntegrationWriteOnlySecrets copies the write-only secrets of the config into data, as they are
+// always null in the plan.
+func applyOutboundIntegrationWriteOnlySecrets(ctx context.Context, config tfsdk.Config, data *OutboundIntegrationModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.WebhookSettings != nil && data.WebhookSettings.Authentication != nil {
+		authenticationPath := path.Root("webhook_settings").AtName("authentication")
+		authentication := data.WebhookSettings.Authentication
+		diags.Append(config.GetAttribute(ctx, authenticationPath.AtName("password"), &authentication.Password)...)
+		diags.Append(config.GetAttribute(ctx, authenticationPath.AtName("token"), &authentication.Token)...)
+		diags.Append(config.GetAttribute(ctx, authenticationPath.AtName("hmac_secret"), &authentication.HmacSecret)...)
+	}
+
+	if data.TicketingSettings != nil && data.TicketingSettings.Credentials != nil {
+		credentialsPath := path.Root("ticketing_settings").AtName("credentials")
+		diags.Append(config.GetAttribute(ctx, credentialsPath.AtName("api_token"), &data.TicketingSettings.Credentials.ApiToken)...)
+	}
+
+	return diags
+}
+
+// MapOutboundWebhookSettingsResponseToModel keeps the secret version of the prior settings. The write-only secrets
+// are never stored in the state.
+func MapOutboundWebhookSettingsResponseToModel(settings *outboundWebhookSettings, prior *OutboundWebhookSettings) *OutboundWebhookSettings {
+	if settings == nil || settings.Url == "" {
+		return nil
+	}
+
+	result := &OutboundWebhookSettings{
+		Url:             types.StringValue(settings.Url),
+		Method:          types.StringValue("POST"),
+		Headers:         MapNullableStringMap(settings.Headers),
+		PayloadTemplate: types.StringPointerValue(settings.PayloadTemplate),
+	}
+
+	if settings.Method != nil {
+		result.Method = types.StringValue(*settings.Method)
+	}
+
+	if settings.Authentication != nil {
+		result.Authentication = &OutboundWebhookAuthentication{
+			Type:           types.StringValue(settings.Authentication.Type),
+			Username:       types.StringPointerValue(settings.Authentication.Username),
+			Password:       types.StringNull(),
+			Token:          types.StringNull(),
+			HmacSecret:     types.StringNull(),
+			HmacHeaderName: types.StringPointerValue(settings.Authentication.HmacHeaderName),
+			SecretVersion:  types.Int64Null(),
+		}
+
+		if prior != nil && prior.Authentication != nil {
+			result.Authentication.SecretVersion = prior.Authentication.SecretVersion
 		}
 	}
 
 	return result
 }
+
+// MapTicketingSettingsResponseToModel keeps the secret version of the prior settings. The write-only api token is
+// never stored in the state.
+func MapTicketingSettingsResponseToModel(ctx context.Context, settings *ticketingSettings, prior *TicketingSettings) *TicketingSettings {
+	if settings == nil || settings.BaseUrl == "" {
+		return nil
+	}
+
+	result := &TicketingSettings{
+		BaseUrl:             types.StringValue(settings.BaseUrl),
+		ProjectKey:          types.StringValue(settings.ProjectKey),
+		IssueType:           types.StringPointerValue(settings.IssueType),
+		PriorityMapping:     MapNullableStringMap(settings.PriorityMapping),
+		FieldMappings:       MapNullableStringMap(settings.FieldMappings),
+		CreateTicketIntents: MapNullableList(ctx, settings.CreateTicketIntents),
+		CommentIntents:      MapNullableList(ctx, settings.CommentIntents),
+		CloseTicketIntents:  MapNullableList(ctx, settings.CloseTicketIntents),
+		Credentials: &TicketingCredentials{
+			Username:      types.StringNull(),
+			ApiToken:      types.StringNull(),
+			SecretVersion: types.Int64Null(),
+		},
+	}
+
+	if settings.Credentials != nil {
+		result.Credentials.Username = types.StringPointerValue(settings.Credentials.Username)
+	}
+
+	if prior != nil && prior.Credentials != nil {
+		result.Credentials.SecretVersion = prior.Credentials.SecretVersion
+	}
+
+	return result
+}
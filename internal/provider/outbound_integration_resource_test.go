@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -46,6 +47,7 @@ func TestAccOutboundIntegrationResourceExample(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks:   testAccWriteOnlyTerraformVersionChecks,
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -60,6 +62,11 @@ func TestAccOutboundIntegrationResourceExample(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "allquiet_outbound_integration.ms_teams",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
 				Config: testAccOutboundIntegrationResourceExample(),
@@ -424,3 +431,348 @@ resource "allquiet_outbound_integration" "mattermost_with_channels" {
 		return baseConfig
 	}
 }
+
+func TestAccOutboundIntegrationResourceMsTeamsSettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create Microsoft Teams integration with selected_channel_ids
+			{
+				Config: testAccOutboundIntegrationMsTeamsSettingsConfig("with_channels"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.ms_teams", "type", "MicrosoftTeams"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.ms_teams", "ms_teams_settings.selected_team_id", "team-id-1"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.ms_teams", "ms_teams_settings.selected_channel_ids.#", "2"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.ms_teams", "ms_teams_settings.tag_on_call_members", "true"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.ms_teams", "ms_teams_settings.on_call_reminder_schedule_settings.run_time", "09:00"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.ms_teams", "ms_teams_settings.on_call_reminder_channel_ids.0", "reminder1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_outbound_integration.ms_teams",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update: Change from selected_channel_ids to severity_based_channel_settings
+			{
+				Config: testAccOutboundIntegrationMsTeamsSettingsConfig("update_severity"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.ms_teams", "ms_teams_settings.severity_based_channel_settings.selected_channel_ids_critical.0", "critical_channel"),
+					resource.TestCheckNoResourceAttr("allquiet_outbound_integration.ms_teams", "ms_teams_settings.selected_channel_ids"),
+					resource.TestCheckNoResourceAttr("allquiet_outbound_integration.ms_teams", "ms_teams_settings.on_call_reminder_schedule_settings"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_outbound_integration.ms_teams",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccOutboundIntegrationMsTeamsSettingsConfig(testType string) string {
+	baseConfig := `
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+`
+
+	switch testType {
+	case "with_channels":
+		return baseConfig + `
+resource "allquiet_outbound_integration" "ms_teams" {
+  display_name = "Microsoft Teams"
+  team_id      = allquiet_team.test.id
+  type         = "MicrosoftTeams"
+
+  ms_teams_settings = {
+    selected_team_id     = "team-id-1"
+    selected_channel_ids = ["channel1", "channel2"]
+    on_call_reminder_schedule_settings = {
+      run_time     = "09:00"
+      days_of_week = ["mon", "wed"]
+    }
+    on_call_reminder_channel_ids = ["reminder1"]
+    tag_on_call_members          = true
+  }
+}
+`
+	case "update_severity":
+		return baseConfig + `
+resource "allquiet_outbound_integration" "ms_teams" {
+  display_name = "Microsoft Teams"
+  team_id      = allquiet_team.test.id
+  type         = "MicrosoftTeams"
+
+  ms_teams_settings = {
+    selected_team_id = "team-id-1"
+    severity_based_channel_settings = {
+      selected_channel_ids_critical = ["critical_channel"]
+    }
+  }
+}
+`
+	default:
+		return baseConfig
+	}
+}
+
+func TestAccOutboundIntegrationResourceWebhookSettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks:   testAccWriteOnlyTerraformVersionChecks,
+		Steps: []resource.TestStep{
+			// Create webhook integration with bearer authentication
+			{
+				Config: testAccOutboundIntegrationWebhookSettingsConfig("bearer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.webhook", "webhook_settings.url", "https://example.com/hooks/allquiet"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.webhook", "webhook_settings.method", "POST"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.webhook", "webhook_settings.headers.X-Source", "allquiet"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.webhook", "webhook_settings.authentication.type", "Bearer"),
+					resource.TestCheckNoResourceAttr("allquiet_outbound_integration.webhook", "webhook_settings.authentication.token"),
+				),
+			},
+			// ImportState testing, write-only secrets are never stored
+			{
+				ResourceName:      "allquiet_outbound_integration.webhook",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update to HMAC signature authentication and a payload template
+			{
+				Config: testAccOutboundIntegrationWebhookSettingsConfig("hmac"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.webhook", "webhook_settings.method", "PUT"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.webhook", "webhook_settings.authentication.type", "HmacSignature"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.webhook", "webhook_settings.payload_template", "{\"text\": {{ json .title }}}"),
+					resource.TestCheckNoResourceAttr("allquiet_outbound_integration.webhook", "webhook_settings.authentication.token"),
+				),
+			},
+			// Invalid payload template
+			{
+				Config:      testAccOutboundIntegrationWebhookSettingsConfig("invalid_template"),
+				ExpectError: regexp.MustCompile(`Invalid Payload Template`),
+			},
+			// Missing secret
+			{
+				Config:      testAccOutboundIntegrationWebhookSettingsConfig("missing_secret"),
+				ExpectError: regexp.MustCompile(`hmac_secret must be specified`),
+			},
+		},
+	})
+}
+
+func testAccOutboundIntegrationWebhookSettingsConfig(testType string) string {
+	baseConfig := `
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+`
+
+	switch testType {
+	case "bearer":
+		return baseConfig + `
+resource "allquiet_outbound_integration" "webhook" {
+  display_name = "Webhook"
+  team_id      = allquiet_team.test.id
+  type         = "Webhook"
+
+  webhook_settings = {
+    url = "https://example.com/hooks/allquiet"
+    headers = {
+      "X-Source" = "allquiet"
+    }
+    authentication = {
+      type  = "Bearer"
+      token = "secret-token"
+    }
+  }
+}
+`
+	case "hmac":
+		return baseConfig + `
+resource "allquiet_outbound_integration" "webhook" {
+  display_name = "Webhook"
+  team_id      = allquiet_team.test.id
+  type         = "Webhook"
+
+  webhook_settings = {
+    url    = "https://example.com/hooks/allquiet"
+    method = "PUT"
+    authentication = {
+      type           = "HmacSignature"
+      hmac_secret    = "secret"
+      secret_version = 1
+    }
+    payload_template = "{\"text\": {{ json .title }}}"
+  }
+}
+`
+	case "invalid_template":
+		return baseConfig + `
+resource "allquiet_outbound_integration" "webhook" {
+  display_name = "Webhook"
+  team_id      = allquiet_team.test.id
+  type         = "Webhook"
+
+  webhook_settings = {
+    url              = "https://example.com/hooks/allquiet"
+    payload_template = "{{ .title"
+  }
+}
+`
+	case "missing_secret":
+		return baseConfig + `
+resource "allquiet_outbound_integration" "webhook" {
+  display_name = "Webhook"
+  team_id      = allquiet_team.test.id
+  type         = "Webhook"
+
+  webhook_settings = {
+    url = "https://example.com/hooks/allquiet"
+    authentication = {
+      type = "HmacSignature"
+    }
+  }
+}
+`
+	default:
+		return baseConfig
+	}
+}
+
+func TestAccOutboundIntegrationResourceTicketingSettings(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks:   testAccWriteOnlyTerraformVersionChecks,
+		Steps: []resource.TestStep{
+			// Create ticketing integration
+			{
+				Config: testAccOutboundIntegrationTicketingSettingsConfig("create"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.base_url", "https://example.atlassian.net"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.project_key", "OPS"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.priority_mapping.Critical", "Highest"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.field_mappings.customfield_10010", "host"),
+					resource.TestCheckNoResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.credentials.api_token"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.credentials.secret_version", "1"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.create_ticket_intents.0", "Created"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.close_ticket_intents.0", "Resolved"),
+				),
+			},
+			// ImportState testing, the api token is never stored and the secret version is not imported
+			{
+				ResourceName:            "allquiet_outbound_integration.jira",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ticketing_settings.credentials.secret_version"},
+			},
+			// Update intents and mappings
+			{
+				Config: testAccOutboundIntegrationTicketingSettingsConfig("update"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.issue_type", "Bug"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.priority_mapping.Minor", "Lowest"),
+					resource.TestCheckResourceAttr("allquiet_outbound_integration.jira", "ticketing_settings.comment_intents.#", "2"),
+				),
+			},
+			// Invalid severity in priority mapping
+			{
+				Config:      testAccOutboundIntegrationTicketingSettingsConfig("invalid_severity"),
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
+
+func testAccOutboundIntegrationTicketingSettingsConfig(testType string) string {
+	baseConfig := `
+resource "allquiet_team" "test" {
+  display_name = "Root"
+}
+`
+
+	switch testType {
+	case "create":
+		return baseConfig + `
+resource "allquiet_outbound_integration" "jira" {
+  display_name = "Jira"
+  team_id      = allquiet_team.test.id
+  type         = "Jira"
+
+  ticketing_settings = {
+    base_url    = "https://example.atlassian.net"
+    project_key = "OPS"
+    priority_mapping = {
+      Critical = "Highest"
+    }
+    field_mappings = {
+      "customfield_10010" = "host"
+    }
+    credentials = {
+      username       = "ops@example.com"
+      api_token      = "secret-token"
+      secret_version = 1
+    }
+    create_ticket_intents = ["Created"]
+    close_ticket_intents  = ["Resolved"]
+  }
+}
+`
+	case "update":
+		return baseConfig + `
+resource "allquiet_outbound_integration" "jira" {
+  display_name = "Jira"
+  team_id      = allquiet_team.test.id
+  type         = "Jira"
+
+  ticketing_settings = {
+    base_url    = "https://example.atlassian.net"
+    project_key = "OPS"
+    issue_type  = "Bug"
+    priority_mapping = {
+      Critical = "Highest"
+      Minor    = "Lowest"
+    }
+    credentials = {
+      username       = "ops@example.com"
+      api_token      = "secret-token"
+      secret_version = 1
+    }
+    create_ticket_intents = ["Created"]
+    comment_intents       = ["Commented", "Escalated"]
+    close_ticket_intents  = ["Resolved", "Archived"]
+  }
+}
+`
+	case "invalid_severity":
+		return baseConfig + `
+resource "allquiet_outbound_integration" "jira" {
+  display_name = "Jira"
+  team_id      = allquiet_team.test.id
+  type         = "Jira"
+
+  ticketing_settings = {
+    base_url    = "https://example.atlassian.net"
+    project_key = "OPS"
+    priority_mapping = {
+      Blocker = "Highest"
+    }
+    credentials = {
+      api_token      = "secret-token"
+      secret_version = 1
+    }
+  }
+}
+`
+	default:
+		return baseConfig
+	}
+}
@@ -5,8 +5,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
@@ -32,10 +34,13 @@ type AllQuietProvider struct {
 
 // AllQuietProviderModel describes the provider data model.
 type AllQuietProviderModel struct {
-	ApiKey types.String `tfsdk:"api_key"`
-	Region types.String `tfsdk:"api_region"`
+	ApiKey                     types.String `tfsdk:"api_key"`
+	Region                     types.String `tfsdk:"api_region"`
+	MuteWarningThresholdInDays types.Int64  `tfsdk:"mute_warning_threshold_in_days"`
 }
 
+const defaultMuteWarningThresholdInDays = 30
+
 func (p *AllQuietProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "allquiet"
 	resp.Version = p.version
@@ -55,6 +60,13 @@ func (p *AllQuietProvider) Schema(ctx context.Context, req provider.SchemaReques
 					stringvalidator.OneOf([]string{"us", "eu"}...),
 				},
 			},
+			"mute_warning_threshold_in_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of days after which plans warn about integrations that are muted without `muted_until`. Defaults to %d.", defaultMuteWarningThresholdInDays),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -131,6 +143,9 @@ func (p *AllQuietProvider) Configure(ctx context.Context, req provider.Configure
 	}
 
 	client := NewAllQuietAPIClient(apiKey, endpoint, basicAuth)
+	if !config.MuteWarningThresholdInDays.IsNull() {
+		client.MuteWarningThresholdInDays = config.MuteWarningThresholdInDays.ValueInt64()
+	}
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -141,16 +156,23 @@ func (p *AllQuietProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewTeam,
 		NewUser,
 		NewTeamMembership,
+		NewTeamMembers,
 		NewTeamEscalations,
 		NewIntegration,
 		NewIntegrationMapping,
 		NewOutboundIntegration,
 		NewRouting,
+		NewRoutingRule,
 		NewService,
 		NewStatusPage,
+		NewStatusPageMaintenance,
+		NewStatusPageIncidentUpdate,
 		NewOrganizationMembership,
+		NewOrganizationMembers,
 		NewIntegrationMaintenanceWindow,
+		NewIntegrationRecurringMaintenanceWindow,
 		NewOnCallOverride,
+		NewRecurringOnCallOverride,
 	}
 }
 
@@ -163,11 +185,24 @@ func (p *AllQuietProvider) DataSources(ctx context.Context) []func() datasource.
 		NewTeamMembershipDataSource,
 		NewTeamMembershipsDataSource,
 		NewOnCallOverridesDataSource,
+		NewInboundIntegrationTypesDataSource,
+		NewOutboundIntegrationTypesDataSource,
+		NewTeamEscalationsDataSource,
+		NewOrganizationMembershipsDataSource,
+		NewTimeZonesDataSource,
+		NewIntegrationsDataSource,
+		NewScheduleDataSource,
 	}
 }
 
 func (p *AllQuietProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewRenderWebhookPayloadFunction,
+		NewEvaluateMappingFunction,
+		NewHeartbeatWrapperFunction,
+		NewCronNextRunsFunction,
+		NewWebhookSignatureFunction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -6,8 +6,10 @@ package provider
 import (
 	"testing"
 
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -18,6 +20,12 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"allquiet": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testAccWriteOnlyTerraformVersionChecks skip tests of resources with write-only attributes, which
+// require Terraform 1.11 or later.
+var testAccWriteOnlyTerraformVersionChecks = []tfversion.TerraformVersionCheck{
+	tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+}
+
 func testAccPreCheck(t *testing.T) {
 	// You can add code here to run prior to any test case execution, for example assertions
 	// about the appropriate environment variables being set are common to see in a pre-check
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// WeeklyRecurrenceModel is a generic model for a weekly recurring time range
+// that can be reused across recurring resources like maintenance windows and on-call overrides.
+type WeeklyRecurrenceModel struct {
+	SelectedDays types.List   `tfsdk:"selected_days"`
+	From         types.String `tfsdk:"from"`
+	Until        types.String `tfsdk:"until"`
+}
+
+// RecurrenceOccurrenceModel is a generic model for a concrete object created for one occurrence of a recurrence.
+type RecurrenceOccurrenceModel struct {
+	Id    types.String `tfsdk:"id"`
+	Start types.String `tfsdk:"start"`
+	End   types.String `tfsdk:"end"`
+}
+
+var recurrenceOccurrenceType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"id":    types.StringType,
+	"start": types.StringType,
+	"end":   types.StringType,
+}}
+
+type recurrenceOccurrence struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Equal reports whether both occurrences start and end at the same instants.
+func (o recurrenceOccurrence) Equal(other recurrenceOccurrence) bool {
+	return o.Start.Equal(other.Start) && o.End.Equal(other.End)
+}
+
+// WeeklyRecurrenceSchemaAttribute returns the schema of weekly schedules, where noun names what recurs.
+func WeeklyRecurrenceSchemaAttribute(noun string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Required:            true,
+		MarkdownDescription: fmt.Sprintf("Weekly schedules of the %ss", noun),
+		Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"selected_days": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: fmt.Sprintf("Days of the week the %s starts on. Defaults to every day. Possible values are: %s", noun, strings.Join(ValidDaysOfWeek, ", ")),
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(DaysOfWeekValidator("Not a valid day of week")),
+					},
+				},
+				"from": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: fmt.Sprintf("Start time of the %s. Format: HH:mm", noun),
+					Validators:          []validator.String{TimeValidator("Not a valid time")},
+				},
+				"until": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: fmt.Sprintf("End time of the %s. Format: HH:mm. If it is not after `from`, the %s ends on the following day.", noun, noun),
+					Validators:          []validator.String{TimeValidator("Not a valid time")},
+				},
+			},
+		},
+	}
+}
+
+// RecurrenceOccurrencesSchemaAttribute returns the schema of the computed list of objects created for a recurrence.
+func RecurrenceOccurrencesSchemaAttribute(noun string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: fmt.Sprintf("The %ss currently scheduled for this recurrence, ordered by start", noun),
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: fmt.Sprintf("Id of the %s", noun),
+				},
+				"start": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: fmt.Sprintf("Start of the %s (RFC3339 format)", noun),
+				},
+				"end": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: fmt.Sprintf("End of the %s (RFC3339 format)", noun),
+				},
+			},
+		},
+	}
+}
+
+// expandWeeklyRecurrence returns all occurrences of the weekly schedules that have not ended at from
+// and start before until, ordered by start. A schedule whose until time is not after its from time
+// spans midnight and ends on the following day.
+func expandWeeklyRecurrence(schedules []WeeklyRecurrenceModel, location *time.Location, from time.Time, until time.Time) ([]recurrenceOccurrence, error) {
+	localFrom := from.In(location)
+	firstDay := time.Date(localFrom.Year(), localFrom.Month(), localFrom.Day(), 0, 0, 0, 0, location)
+
+	var occurrences []recurrenceOccurrence
+	for _, schedule := range schedules {
+		startTime, err := time.Parse("15:04", schedule.From.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid from %s: %w", schedule.From.ValueString(), err)
+		}
+		endTime, err := time.Parse("15:04", schedule.Until.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid until %s: %w", schedule.Until.ValueString(), err)
+		}
+
+		selectedDays := ValidDaysOfWeek
+		if days := ListToStringArray(schedule.SelectedDays); days != nil {
+			selectedDays = *days
+		}
+
+		// Start one day early to catch an occurrence that began the day before and spans midnight
+		for day := firstDay.AddDate(0, 0, -1); day.Before(until); day = day.AddDate(0, 0, 1) {
+			if !slices.Contains(selectedDays, ValidDaysOfWeek[day.Weekday()]) {
+				continue
+			}
+
+			start := time.Date(day.Year(), day.Month(), day.Day(), startTime.Hour(), startTime.Minute(), 0, 0, location)
+			end := time.Date(day.Year(), day.Month(), day.Day(), endTime.Hour(), endTime.Minute(), 0, 0, location)
+			if !end.After(start) {
+				end = time.Date(day.Year(), day.Month(), day.Day()+1, endTime.Hour(), endTime.Minute(), 0, 0, location)
+			}
+
+			if !end.After(from) || !start.Before(until) {
+				continue
+			}
+
+			occurrences = append(occurrences, recurrenceOccurrence{Start: start.UTC(), End: end.UTC()})
+		}
+	}
+
+	slices.SortFunc(occurrences, func(a, b recurrenceOccurrence) int {
+		if c := a.Start.Compare(b.Start); c != 0 {
+			return c
+		}
+		return a.End.Compare(b.End)
+	})
+
+	return slices.Compact(occurrences), nil
+}
+
+// mapRecurrenceOccurrenceModel parses the start and end returned by the API, which may carry an offset or
+// fractional seconds. Occurrences are compared in UTC.
+func mapRecurrenceOccurrenceModel(model RecurrenceOccurrenceModel) (recurrenceOccurrence, error) {
+	start, err := time.Parse(time.RFC3339, model.Start.ValueString())
+	if err != nil {
+		return recurrenceOccurrence{}, fmt.Errorf("invalid start of %s: %w", model.Id.ValueString(), err)
+	}
+
+	end, err := time.Parse(time.RFC3339, model.End.ValueString())
+	if err != nil {
+		return recurrenceOccurrence{}, fmt.Errorf("invalid end of %s: %w", model.Id.ValueString(), err)
+	}
+
+	return recurrenceOccurrence{Start: start.UTC(), End: end.UTC()}, nil
+}
+
+// MapRecurrenceOccurrencesToList orders the occurrences by start and leaves out those that have ended at now.
+func MapRecurrenceOccurrencesToList(ctx context.Context, models []RecurrenceOccurrenceModel, now time.Time) (types.List, diag.Diagnostics) {
+	result := []RecurrenceOccurrenceModel{}
+	for _, model := range models {
+		end, err := time.Parse(time.RFC3339, model.End.ValueString())
+		if err == nil && !end.After(now) {
+			continue
+		}
+
+		result = append(result, model)
+	}
+
+	slices.SortFunc(result, func(a, b RecurrenceOccurrenceModel) int {
+		return strings.Compare(a.Start.ValueString(), b.Start.ValueString())
+	})
+
+	return types.ListValueFrom(ctx, recurrenceOccurrenceType, result)
+}
+
+// recurrenceChanges are the changes that converge the objects created for a recurrence to its occurrences.
+type recurrenceChanges struct {
+	Keep   []RecurrenceOccurrenceModel
+	Create []recurrenceOccurrence
+	Delete []RecurrenceOccurrenceModel
+}
+
+func (c recurrenceChanges) IsEmpty() bool {
+	return len(c.Create) == 0 && len(c.Delete) == 0
+}
+
+// planRecurrenceChanges keeps the objects that match an occurrence, deletes the others and creates the missing
+// occurrences. Objects that have ended at now are released without being deleted. If keepStarted is set, objects
+// that have started are kept as they are and no occurrence overlapping them is created.
+func planRecurrenceChanges(current []RecurrenceOccurrenceModel, occurrences []recurrenceOccurrence, now time.Time, keepStarted bool) (recurrenceChanges, error) {
+	var changes recurrenceChanges
+	var started []recurrenceOccurrence
+	occurrences = slices.Clone(occurrences)
+
+	for _, model := range current {
+		occurrence, err := mapRecurrenceOccurrenceModel(model)
+		if err != nil {
+			return recurrenceChanges{}, err
+		}
+
+		if !occurrence.End.After(now) {
+			continue
+		}
+
+		if index := slices.IndexFunc(occurrences, occurrence.Equal); index >= 0 {
+			occurrences = slices.Delete(occurrences, index, index+1)
+			changes.Keep = append(changes.Keep, model)
+			continue
+		}
+
+		if keepStarted && !occurrence.Start.After(now) {
+			started = append(started, occurrence)
+			changes.Keep = append(changes.Keep, model)
+			continue
+		}
+
+		changes.Delete = append(changes.Delete, model)
+	}
+
+	for _, occurrence := range occurrences {
+		overlaps := slices.ContainsFunc(started, func(s recurrenceOccurrence) bool {
+			return occurrence.Start.Before(s.End) && s.Start.Before(occurrence.End)
+		})
+		if !overlaps {
+			changes.Create = append(changes.Create, occurrence)
+		}
+	}
+
+	return changes, nil
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/AllQuietApp/terraform-provider-internal/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func (c *AllQuietAPIClient) GetRecurringOnCallOverrides(ctx context.Context, overrides []RecurrenceOccurrenceModel) ([]onCallOverrideResponse, error) {
+	var result []onCallOverrideResponse
+	for _, override := range overrides {
+		response, err := c.GetOnCallOverrideResource(ctx, override.Id.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		// Overrides deleted outside of Terraform are recreated on the next apply
+		if response == nil {
+			continue
+		}
+
+		result = append(result, *response)
+	}
+
+	return result, nil
+}
+
+// SyncRecurringOnCallOverrides applies the changes planned by planRecurrenceChanges.
+// Kept overrides that have not started yet are only updated if updateKept is set. On error, the overrides
+// that exist at that point are returned together with the error, so they can be kept in the state.
+func (c *AllQuietAPIClient) SyncRecurringOnCallOverrides(ctx context.Context, data *RecurringOnCallOverrideModel, changes recurrenceChanges, now time.Time, updateKept bool) ([]onCallOverrideResponse, error) {
+	var result []onCallOverrideResponse
+
+	for i, override := range changes.Keep {
+		occurrence, err := mapRecurrenceOccurrenceModel(override)
+		if err == nil {
+			if !updateKept || !occurrence.Start.After(now) {
+				result = append(result, mapRecurringOnCallOverrideModelToResponse(override))
+				continue
+			}
+
+			var response *onCallOverrideResponse
+			response, err = c.UpdateOnCallOverrideResource(ctx, override.Id.ValueString(), mapRecurringOnCallOverrideOccurrence(data, occurrence))
+			if err == nil {
+				result = append(result, *response)
+				continue
+			}
+		}
+
+		for _, remaining := range append(slices.Clone(changes.Keep[i:]), changes.Delete...) {
+			result = append(result, mapRecurringOnCallOverrideModelToResponse(remaining))
+		}
+		return result, err
+	}
+
+	for i, override := range changes.Delete {
+		err := c.DeleteOnCallOverrideResource(ctx, override.Id.ValueString())
+		if err != nil {
+			for _, remaining := range changes.Delete[i:] {
+				result = append(result, mapRecurringOnCallOverrideModelToResponse(remaining))
+			}
+			return result, err
+		}
+	}
+
+	for _, occurrence := range changes.Create {
+		response, err := c.CreateOnCallOverrideResource(ctx, mapRecurringOnCallOverrideOccurrence(data, occurrence))
+		if err != nil {
+			return result, err
+		}
+
+		result = append(result, *response)
+	}
+
+	return result, nil
+}
+
+func mapRecurringOnCallOverrideModelToResponse(override RecurrenceOccurrenceModel) onCallOverrideResponse {
+	return onCallOverrideResponse{Id: override.Id.ValueString(), Start: override.Start.ValueString(), End: override.End.ValueString()}
+}
+
+func (c *AllQuietAPIClient) DeleteRecurringOnCallOverrides(ctx context.Context, overrides []RecurrenceOccurrenceModel) error {
+	for _, override := range overrides {
+		err := c.DeleteOnCallOverrideResource(ctx, override.Id.ValueString())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func mapRecurringOnCallOverrideOccurrence(data *RecurringOnCallOverrideModel, occurrence recurrenceOccurrence) *OnCallOverrideModel {
+	return &OnCallOverrideModel{
+		UserId:             data.UserId,
+		TeamId:             data.TeamId,
+		Type:               data.Type,
+		Start:              types.StringValue(occurrence.Start.UTC().Format(validators.DateTimeLayout)),
+		End:                types.StringValue(occurrence.End.UTC().Format(validators.DateTimeLayout)),
+		ReplacementUserIds: data.ReplacementUserIds,
+	}
+}
@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/AllQuietApp/terraform-provider-internal/internal/provider/validators"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RecurringOnCallOverride{}
+var _ resource.ResourceWithModifyPlan = &RecurringOnCallOverride{}
+
+func NewRecurringOnCallOverride() resource.Resource {
+	return &RecurringOnCallOverride{}
+}
+
+type RecurringOnCallOverride struct {
+	client *AllQuietAPIClient
+}
+
+type RecurringOnCallOverrideModel struct {
+	Id                 types.String            `tfsdk:"id"`
+	UserId             types.String            `tfsdk:"user_id"`
+	TeamId             types.String            `tfsdk:"team_id"`
+	Type               types.String            `tfsdk:"type"`
+	ReplacementUserIds types.List              `tfsdk:"replacement_user_ids"`
+	TimeZoneId         types.String            `tfsdk:"time_zone_id"`
+	WeeklySchedules    []WeeklyRecurrenceModel `tfsdk:"weekly_schedules"`
+	Start              types.String            `tfsdk:"start"`
+	Until              types.String            `tfsdk:"until"`
+	Overrides          types.List              `tfsdk:"overrides"`
+}
+
+// maxRecurringOnCallOverrides limits the number of overrides a single recurrence creates.
+const maxRecurringOnCallOverrides = 500
+
+func (r *RecurringOnCallOverride) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_recurring_on_call_override"
+}
+
+func (r *RecurringOnCallOverride) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The `recurring_on_call_override` resource represents a recurring on-call override of a user in All Quiet, e.g. a part-time engineer who is offline every Friday. " +
+			"The provider creates one on-call override for every occurrence of the weekly schedules until `until` and manages them as a group: missing overrides are created and removed ones are deleted. " +
+			"Overrides that have already started are left alone.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The user id of the user",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The team id to scope the overrides to. When specified, the overrides apply only to the specified team.",
+				Optional:            true,
+				Validators:          []validator.String{GuidValidator("Not a valid GUID")},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the overrides. Possible values are: " + strings.Join(ValidOnCallOverrideTypes, ", "),
+				Required:            true,
+				Validators:          []validator.String{OnCallOverrideTypeValidator("Invalid on call override type")},
+			},
+			"replacement_user_ids": schema.ListAttribute{
+				MarkdownDescription: "Replacement user ids",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(GuidValidator("Not a valid GUID")),
+				},
+			},
+			"time_zone_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The time zone id the weekly schedules are defined in. Find all timezone ids [here](https://allquiet.app/api/public/v1/timezone) or use the `allquiet_time_zones` data source",
+				Validators:          []validator.String{TimeZoneValidator("Not a valid time zone id")},
+			},
+			"weekly_schedules": WeeklyRecurrenceSchemaAttribute("override"),
+			"start": schema.StringAttribute{
+				Optional:    true,
+				Description: "Date / time the recurrence starts (RFC3339 format). Defaults to now.",
+				Validators:  []validator.String{DateTimeValidator("Not a valid date / time")},
+			},
+			"until": schema.StringAttribute{
+				Required:    true,
+				Description: "Date / time the recurrence ends (RFC3339 format). No override starts after it.",
+				Validators:  []validator.String{DateTimeValidator("Not a valid date / time")},
+			},
+			"overrides": RecurrenceOccurrencesSchemaAttribute("override"),
+		},
+	}
+}
+
+func (r *RecurringOnCallOverride) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AllQuietAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RecurringOnCallOverride) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan RecurringOnCallOverrideModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.TimeZoneId.IsUnknown() || plan.Start.IsUnknown() || plan.Until.IsUnknown() {
+		return
+	}
+
+	now := time.Now()
+	occurrences, err := expandRecurringOnCallOverride(&plan, now)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Recurrence", err.Error())
+		return
+	}
+
+	// Nothing to compare against on create
+	if req.State.Raw.IsNull() || plan.Overrides.IsUnknown() {
+		return
+	}
+
+	var state RecurringOnCallOverrideModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var overrides []RecurrenceOccurrenceModel
+	resp.Diagnostics.Append(state.Overrides.ElementsAs(ctx, &overrides, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	changes, err := planRecurrenceChanges(overrides, occurrences, now, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to plan recurring on call override resource, got error: %s", err))
+		return
+	}
+
+	// Overrides were deleted outside of Terraform, so the overrides are synced on apply
+	if !changes.IsEmpty() {
+		plan.Overrides = types.ListUnknown(recurrenceOccurrenceType)
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+	}
+}
+
+func (r *RecurringOnCallOverride) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RecurringOnCallOverrideModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	occurrences, err := expandRecurringOnCallOverride(&data, now)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create recurring on call override resource, got error: %s", err))
+		return
+	}
+
+	changes, err := planRecurrenceChanges(nil, occurrences, now, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create recurring on call override resource, got error: %s", err))
+		return
+	}
+
+	overrideResponses, err := r.client.SyncRecurringOnCallOverrides(ctx, &data, changes, now, false)
+	if err != nil && len(overrideResponses) == 0 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create recurring on call override resource, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(uuid.New().String())
+	resp.Diagnostics.Append(mapRecurringOnCallOverrideResponseToModel(ctx, overrideResponses, now, &data)...)
+
+	// The overrides created before the error are kept in the state, so they are not orphaned
+	if err != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create recurring on call override resource, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "created recurring on call override resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecurringOnCallOverride) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RecurringOnCallOverrideModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var overrides []RecurrenceOccurrenceModel
+	resp.Diagnostics.Append(data.Overrides.ElementsAs(ctx, &overrides, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	overrideResponses, err := r.client.GetRecurringOnCallOverrides(ctx, overrides)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get recurring on call override resource, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(mapRecurringOnCallOverrideResponseToModel(ctx, overrideResponses, time.Now(), &data)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecurringOnCallOverride) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RecurringOnCallOverrideModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var overrides []RecurrenceOccurrenceModel
+	resp.Diagnostics.Append(state.Overrides.ElementsAs(ctx, &overrides, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	occurrences, err := expandRecurringOnCallOverride(&data, now)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update recurring on call override resource, got error: %s", err))
+		return
+	}
+
+	updateKept := !data.Type.Equal(state.Type) || !data.ReplacementUserIds.Equal(state.ReplacementUserIds)
+	changes, err := planRecurrenceChanges(overrides, occurrences, now, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update recurring on call override resource, got error: %s", err))
+		return
+	}
+
+	overrideResponses, err := r.client.SyncRecurringOnCallOverrides(ctx, &data, changes, now, updateKept)
+	resp.Diagnostics.Append(mapRecurringOnCallOverrideResponseToModel(ctx, overrideResponses, now, &data)...)
+
+	// The overrides that exist after the error are kept in the state, so they are not orphaned
+	if err != nil {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update recurring on call override resource, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "updated recurring on call override resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RecurringOnCallOverride) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RecurringOnCallOverrideModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var overrides []RecurrenceOccurrenceModel
+	resp.Diagnostics.Append(data.Overrides.ElementsAs(ctx, &overrides, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Overrides that have already started are left alone
+	now := time.Now()
+	var upcoming []RecurrenceOccurrenceModel
+	for _, override := range overrides {
+		occurrence, err := mapRecurrenceOccurrenceModel(override)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete recurring on call override resource, got error: %s", err))
+			return
+		}
+
+		if occurrence.Start.After(now) {
+			upcoming = append(upcoming, override)
+		}
+	}
+
+	err := r.client.DeleteRecurringOnCallOverrides(ctx, upcoming)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete recurring on call override resource, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted recurring on call override resource")
+}
+
+func expandRecurringOnCallOverride(data *RecurringOnCallOverrideModel, now time.Time) ([]recurrenceOccurrence, error) {
+	location, err := time.LoadLocation(data.TimeZoneId.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	from := now
+	if !data.Start.IsNull() {
+		start, err := time.Parse(validators.DateTimeLayout, data.Start.ValueString())
+		if err != nil {
+			return nil, err
+		}
+		if start.After(from) {
+			from = start
+		}
+	}
+
+	until, err := time.Parse(validators.DateTimeLayout, data.Until.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences, err := expandWeeklyRecurrence(data.WeeklySchedules, location, from, until)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(occurrences) > maxRecurringOnCallOverrides {
+		return nil, fmt.Errorf("the recurrence creates %d overrides until %s, which is more than the maximum of %d; choose an earlier until", len(occurrences), data.Until.ValueString(), maxRecurringOnCallOverrides)
+	}
+
+	return occurrences, nil
+}
+
+func mapRecurringOnCallOverrideResponseToModel(ctx context.Context, responses []onCallOverrideResponse, now time.Time, data *RecurringOnCallOverrideModel) diag.Diagnostics {
+	var overrides []RecurrenceOccurrenceModel
+	for _, response := range responses {
+		overrides = append(overrides, RecurrenceOccurrenceModel{
+			Id:    types.StringValue(response.Id),
+			Start: types.StringValue(response.Start),
+			End:   types.StringValue(response.End),
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.Overrides, diags = MapRecurrenceOccurrencesToList(ctx, overrides, now)
+	return diags
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/AllQuietApp/terraform-provider-internal/internal/provider/validators"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRecurringOnCallOverrideResource(t *testing.T) {
+	until := time.Now().AddDate(0, 0, 21).UTC().Format(validators.DateTimeLayout)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRecurringOnCallOverrideResourceConfig("offline", "[\"fri\"]", until),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_recurring_on_call_override.test", "type", "offline"),
+					resource.TestCheckResourceAttrSet("allquiet_recurring_on_call_override.test", "overrides.0.id"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccRecurringOnCallOverrideResourceConfig("online", "[\"mon\", \"fri\"]", until),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_recurring_on_call_override.test", "type", "online"),
+					resource.TestCheckResourceAttrSet("allquiet_recurring_on_call_override.test", "overrides.3.id"),
+				),
+			},
+			// Too many overrides testing
+			{
+				Config:      testAccRecurringOnCallOverrideResourceConfig("online", "[\"mon\", \"fri\"]", "2099-01-01T00:00:00Z"),
+				ExpectError: regexp.MustCompile(`choose an earlier until`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRecurringOnCallOverrideResourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRecurringOnCallOverrideResourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("allquiet_recurring_on_call_override.millie_brown_fridays_off", "overrides.0.id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRecurringOnCallOverrideResourceConfig(overrideType string, selectedDays string, until string) string {
+	return fmt.Sprintf(`
+resource "allquiet_user" "test" {
+  display_name = "Recurring Override Test"
+  email        = "acceptance-tests+recurring-override+%[4]s@allquiet.app"
+}
+
+resource "allquiet_recurring_on_call_override" "test" {
+  user_id      = allquiet_user.test.id
+  type         = %[1]q
+  time_zone_id = "Europe/Zurich"
+  until        = %[3]q
+  weekly_schedules = [
+    {
+      selected_days = %[2]s
+      from          = "08:00"
+      until         = "17:00"
+    }
+  ]
+}
+`, overrideType, selectedDays, until, uuid.New().String())
+}
+
+func testAccRecurringOnCallOverrideResourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/resources/allquiet_recurring_on_call_override/resource.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return RandomizeExample(string(dat))
+}
@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/AllQuietApp/terraform-provider-internal/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &RenderWebhookPayloadFunction{}
+
+func NewRenderWebhookPayloadFunction() function.Function {
+	return &RenderWebhookPayloadFunction{}
+}
+
+// RenderWebhookPayloadFunction renders a webhook payload template against a sample incident.
+type RenderWebhookPayloadFunction struct{}
+
+// sampleWebhookIncident is the incident templates are rendered against if no incident is given.
+const sampleWebhookIncident = `{
+  "id": "00000000-0000-0000-0000-000000000000",
+  "title": "High CPU usage on web-1",
+  "status": "Open",
+  "severity": "Critical",
+  "url": "https://allquiet.app/incidents/00000000-0000-0000-0000-000000000000",
+  "integrationDisplayName": "Datadog",
+  "teamDisplayName": "Engineering",
+  "createdAt": "2025-01-01T00:00:00Z",
+  "attributes": [
+    { "name": "host", "value": "web-1" },
+    { "name": "cpu", "value": "97%" }
+  ]
+}`
+
+func (f *RenderWebhookPayloadFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "render_webhook_payload"
+}
+
+func (f *RenderWebhookPayloadFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Renders a webhook payload template",
+		MarkdownDescription: "Renders the `payload_template` of an outbound integration's `webhook_settings` against an incident, so templates can be tested before they are applied.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "template",
+				MarkdownDescription: "The payload template in Go template syntax",
+			},
+			function.StringParameter{
+				Name:                "incident",
+				MarkdownDescription: "The incident as JSON, e.g. from `jsonencode`. If null, a sample incident is used.",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *RenderWebhookPayloadFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var text string
+	var incidentJson *string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &text, &incidentJson))
+	if resp.Error != nil {
+		return
+	}
+
+	tmpl, err := validators.ParsePayloadTemplate(text)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid payload template: %s", err))
+		return
+	}
+
+	incidentData := sampleWebhookIncident
+	if incidentJson != nil {
+		incidentData = *incidentJson
+	}
+
+	var incident map[string]any
+	err = json.Unmarshal([]byte(incidentData), &incident)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid incident: %s", err))
+		return
+	}
+
+	var payload bytes.Buffer
+	err = tmpl.Execute(&payload, incident)
+	if err != nil {
+		resp.Error = function.NewFuncError(fmt.Sprintf("Unable to render payload template: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, payload.String()))
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRenderWebhookPayloadFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::render_webhook_payload("{{ upper .severity }}: {{ json .title }}", null)
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `CRITICAL: "High CPU usage on web-1"`),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::render_webhook_payload("{{ .title }}", jsonencode({ title = "Custom" }))
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "Custom"),
+				),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::render_webhook_payload("{{ .title", null)
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid payload template`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::render_webhook_payload("{{ .missing }}", null)
+}
+`,
+				ExpectError: regexp.MustCompile(`Unable to render payload template`),
+			},
+		},
+	})
+}
+
+func TestAccRenderWebhookPayloadFunctionExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRenderWebhookPayloadFunctionExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("sample_payload", `{"summary": "High CPU usage on web-1", "severity": "critical"}`),
+					resource.TestCheckOutput("custom_payload", "Disk full on db-1 (Warning)"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRenderWebhookPayloadFunctionExample() string {
+	absPath, _ := filepath.Abs("../../examples/functions/render_webhook_payload/function.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(dat)
+}
@@ -1,11 +1,21 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	maxRoutingUpdateAttempts = 5
+	routingUpdateRetryDelay  = 500 * time.Millisecond
 )
 
 type routingResponse struct {
@@ -17,6 +27,9 @@ type routingResponse struct {
 }
 
 type routingRule struct {
+	// Priority is only sent for rules attached by allquiet_routing_rule resources. Routings without attached
+	// rules never carry it and are written as a whole, see UpdateRoutingResource.
+	Priority    *int64                 `json:"priority,omitempty"`
 	DisplayName *string                `json:"displayName"`
 	Conditions  *routingRuleConditions `json:"conditions"`
 	Actions     *routingRuleActions    `json:"actions"`
@@ -101,16 +114,61 @@ func mapRoutingCreateRequest(plan *RoutingModel) *routingCreateRequest {
 func mapRoutingRules(rules []RoutingRuleModel) []routingRule {
 	result := make([]routingRule, len(rules))
 	for i, rule := range rules {
-		result[i] = routingRule{
-			DisplayName: rule.DisplayName.ValueStringPointer(),
-			Conditions:  mapRoutingRuleConditions(rule.Conditions),
-			Actions:     mapRoutingRuleActions(rule.Actions),
-			Channels:    mapRoutingRuleChannels(rule.Channels),
-		}
+		result[i] = mapRoutingRule(rule)
 	}
 	return result
 }
 
+func mapRoutingRule(rule RoutingRuleModel) routingRule {
+	return routingRule{
+		DisplayName: rule.DisplayName.ValueStringPointer(),
+		Conditions:  mapRoutingRuleConditions(rule.Conditions),
+		Actions:     mapRoutingRuleActions(rule.Actions),
+		Channels:    mapRoutingRuleChannels(rule.Channels),
+	}
+}
+
+// splitRoutingRules separates the inline rules managed by the allquiet_routing resource from the
+// rules attached by allquiet_routing_rule resources. Attached rules are the ones carrying a priority.
+func splitRoutingRules(rules []routingRule) (inline []routingRule, attached []routingRule) {
+	for _, rule := range rules {
+		if rule.Priority != nil {
+			attached = append(attached, rule)
+		} else {
+			inline = append(inline, rule)
+		}
+	}
+	return inline, attached
+}
+
+// mergeRoutingRules returns the inline rules in their given order, followed by the attached rules
+// in ascending order of their priority.
+func mergeRoutingRules(inline []routingRule, attached []routingRule) []routingRule {
+	sorted := slices.Clone(attached)
+	slices.SortStableFunc(sorted, func(a, b routingRule) int {
+		return cmp.Compare(*a.Priority, *b.Priority)
+	})
+
+	result := make([]routingRule, 0, len(inline)+len(sorted))
+	result = append(result, inline...)
+	return append(result, sorted...)
+}
+
+func findRoutingRuleByPriority(rules []routingRule, priority int64) *routingRule {
+	for i := range rules {
+		if rules[i].Priority != nil && *rules[i].Priority == priority {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+func removeRoutingRuleByPriority(rules []routingRule, priority int64) []routingRule {
+	return slices.DeleteFunc(slices.Clone(rules), func(rule routingRule) bool {
+		return rule.Priority != nil && *rule.Priority == priority
+	})
+}
+
 func mapRoutingRuleConditions(conditions *RoutingRuleConditionsModel) *routingRuleConditions {
 	if conditions == nil {
 		return nil
@@ -251,46 +309,170 @@ func (c *AllQuietAPIClient) DeleteRoutingResource(ctx context.Context, id string
 	return nil
 }
 
+// UpdateRoutingResource replaces the inline rules of the routing. As long as no allquiet_routing_rule is
+// attached, the routing is written as a whole. Otherwise the attached rules are merged back in with the
+// ETag-protected read-modify-write cycle of the routing rules.
 func (c *AllQuietAPIClient) UpdateRoutingResource(ctx context.Context, id string, data *RoutingModel) (*routingResponse, error) {
-	reqBody := mapRoutingCreateRequest(data)
+	unlock := c.lock("routing/" + id)
+	defer unlock()
 
-	url := fmt.Sprintf("/routing/%s", url.PathEscape(id))
-	httpResp, err := c.put(ctx, url, reqBody)
+	current, err := c.GetRoutingResource(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if current != nil {
+		if _, attached := splitRoutingRules(current.Rules); len(attached) > 0 {
+			return c.readModifyWriteRoutingResource(ctx, id, func(routing *routingCreateRequest) error {
+				_, attached := splitRoutingRules(routing.Rules)
+
+				*routing = *mapRoutingCreateRequest(data)
+				routing.Rules = mergeRoutingRules(routing.Rules, attached)
+				return nil
+			})
+		}
+	}
+
+	result, conflict, err := c.putRoutingResource(ctx, id, mapRoutingCreateRequest(data), "")
 	if err != nil {
 		return nil, err
 	}
+
+	if conflict {
+		return nil, fmt.Errorf("routing %s was modified concurrently", id)
+	}
+
+	return result, nil
+}
+
+// modifyRoutingResource reads the routing with the given id, lets modify change it and writes it back.
+// Modifications made through this provider are serialized per routing.
+func (c *AllQuietAPIClient) modifyRoutingResource(ctx context.Context, id string, modify func(routing *routingCreateRequest) error) (*routingResponse, error) {
+	unlock := c.lock("routing/" + id)
+	defer unlock()
+
+	return c.readModifyWriteRoutingResource(ctx, id, modify)
+}
+
+// readModifyWriteRoutingResource writes the routing only if it did not change since it was read, using its
+// ETag. If the API rejects the write because the routing was changed concurrently by someone else, the whole
+// cycle is retried. The caller has to hold the lock of the routing.
+func (c *AllQuietAPIClient) readModifyWriteRoutingResource(ctx context.Context, id string, modify func(routing *routingCreateRequest) error) (*routingResponse, error) {
+	for attempt := 1; ; attempt++ {
+		current, etag, err := c.getRoutingResourceWithETag(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if current == nil {
+			return nil, fmt.Errorf("routing %s not found", id)
+		}
+
+		// Without an ETag, concurrent writes from other workspaces would be silently overwritten.
+		if etag == "" {
+			return nil, fmt.Errorf("the API did not return an ETag for routing %s, refusing to modify its attached rules without protection against concurrent updates", id)
+		}
+
+		routing := &routingCreateRequest{
+			DisplayName:            current.DisplayName,
+			TeamId:                 current.TeamId,
+			Rules:                  current.Rules,
+			TeamConnectionSettings: current.TeamConnectionSettings,
+		}
+
+		err = modify(routing)
+		if err != nil {
+			return nil, err
+		}
+
+		result, conflict, err := c.putRoutingResource(ctx, id, routing, etag)
+		if err != nil {
+			return nil, err
+		}
+
+		if !conflict {
+			return result, verifyAttachedRoutingRules(id, routing.Rules, result.Rules)
+		}
+
+		if attempt == maxRoutingUpdateAttempts {
+			return nil, fmt.Errorf("routing %s was modified concurrently, giving up after %d attempts", id, attempt)
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("routing %s was modified concurrently, retrying", id))
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(attempt) * routingUpdateRetryDelay):
+		}
+	}
+}
+
+// verifyAttachedRoutingRules fails if the API did not persist the priority of every attached rule that was
+// written. Attached rules are recognized by their priority only, without it they would be taken for inline rules.
+func verifyAttachedRoutingRules(id string, written []routingRule, persisted []routingRule) error {
+	_, writtenAttached := splitRoutingRules(written)
+	for _, rule := range writtenAttached {
+		if findRoutingRuleByPriority(persisted, *rule.Priority) == nil {
+			return fmt.Errorf("the API did not persist the rule with priority %d of routing %s, attached routing rules are not supported", *rule.Priority, id)
+		}
+	}
+	return nil
+}
+
+func (c *AllQuietAPIClient) putRoutingResource(ctx context.Context, id string, reqBody *routingCreateRequest, etag string) (*routingResponse, bool, error) {
+	url := fmt.Sprintf("/routing/%s", url.PathEscape(id))
+	httpResp, err := c.putIfMatch(ctx, url, reqBody, etag)
+	if err != nil {
+		return nil, false, err
+	}
 	defer httpResp.Body.Close()
 
+	if isConflictResponse(httpResp) {
+		return nil, true, nil
+	}
+
 	if httpResp.StatusCode != http.StatusOK {
-		return nil, logErrorResponse(httpResp, nil)
+		return nil, false, logErrorResponse(httpResp, nil)
 	}
 
 	var result routingResponse
 	err = json.NewDecoder(httpResp.Body).Decode(&result)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
-	return &result, nil
+	return &result, false, nil
 }
 
 func (c *AllQuietAPIClient) GetRoutingResource(ctx context.Context, id string) (*routingResponse, error) {
+	result, _, err := c.getRoutingResourceWithETag(ctx, id)
+	return result, err
+}
+
+// getRoutingResourceWithETag returns the routing together with its ETag, which is empty if the API
+// did not send one. Returns nil if the routing does not exist.
+func (c *AllQuietAPIClient) getRoutingResourceWithETag(ctx context.Context, id string) (*routingResponse, string, error) {
 	url := fmt.Sprintf("/routing/%s", url.PathEscape(id))
 	httpResp, err := c.get(ctx, url)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	defer httpResp.Body.Close()
 
+	if httpResp.StatusCode == http.StatusNotFound {
+		return nil, "", nil
+	}
+
 	if httpResp.StatusCode != http.StatusOK {
-		return nil, logErrorResponse(httpResp, nil)
+		return nil, "", logErrorResponse(httpResp, nil)
 	}
 
 	var result routingResponse
 	err = json.NewDecoder(httpResp.Body).Decode(&result)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return &result, nil
+	return &result, httpResp.Header.Get("ETag"), nil
 }
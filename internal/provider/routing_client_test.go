@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// fakeRoutingAPI serves a single routing. Every write bumps its ETag, and writes with an outdated
+// If-Match header are rejected like the API does for concurrent updates.
+type fakeRoutingAPI struct {
+	mutex    sync.Mutex
+	routing  routingResponse
+	etag     string
+	version  int
+	withETag bool
+
+	// conflicts is the number of writes rejected as if the routing was changed concurrently.
+	conflicts int
+	puts      []http.Header
+}
+
+func (f *fakeRoutingAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if r.URL.Path != "/routing/"+f.routing.Id {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		if f.withETag {
+			w.Header().Set("ETag", f.etag)
+		}
+		_ = json.NewEncoder(w).Encode(f.routing)
+	case http.MethodPut:
+		f.puts = append(f.puts, r.Header.Clone())
+
+		if ifMatch := r.Header.Get("If-Match"); ifMatch != "" && ifMatch != f.etag {
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		if f.conflicts > 0 {
+			f.conflicts--
+			f.bump()
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+
+		var request routingCreateRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		f.routing.DisplayName = request.DisplayName
+		f.routing.TeamId = request.TeamId
+		f.routing.Rules = request.Rules
+		f.routing.TeamConnectionSettings = request.TeamConnectionSettings
+		f.bump()
+		_ = json.NewEncoder(w).Encode(f.routing)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (f *fakeRoutingAPI) bump() {
+	f.version++
+	f.etag = strings.Repeat("v", f.version)
+}
+
+func newFakeRoutingAPI(t *testing.T, withETag bool, rules ...routingRule) (*fakeRoutingAPI, *AllQuietAPIClient) {
+	api := &fakeRoutingAPI{
+		routing:  routingResponse{Id: "routing-1", DisplayName: "Platform", TeamId: "team-1", Rules: rules},
+		withETag: withETag,
+	}
+	api.bump()
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	return api, NewAllQuietAPIClient("api-key", server.URL, nil)
+}
+
+func testRoutingRule(displayName string, priority *int64) routingRule {
+	return routingRule{DisplayName: &displayName, Priority: priority}
+}
+
+func testPriority(priority int64) *int64 {
+	return &priority
+}
+
+func testRoutingModel(ruleDisplayNames ...string) *RoutingModel {
+	data := &RoutingModel{
+		DisplayName: types.StringValue("Platform"),
+		TeamId:      types.StringValue("team-1"),
+	}
+	for _, displayName := range ruleDisplayNames {
+		data.Rules = append(data.Rules, RoutingRuleModel{DisplayName: types.StringValue(displayName)})
+	}
+	return data
+}
+
+func routingRuleDisplayNames(rules []routingRule) []string {
+	var result []string
+	for _, rule := range rules {
+		result = append(result, *rule.DisplayName)
+	}
+	return result
+}
+
+func TestMergeRoutingRules(t *testing.T) {
+	inline := []routingRule{testRoutingRule("b", nil), testRoutingRule("a", nil)}
+	attached := []routingRule{
+		testRoutingRule("thirty", testPriority(30)),
+		testRoutingRule("ten", testPriority(10)),
+		testRoutingRule("twenty", testPriority(20)),
+	}
+
+	merged := mergeRoutingRules(inline, attached)
+	if got, want := routingRuleDisplayNames(merged), []string{"b", "a", "ten", "twenty", "thirty"}; !slices.Equal(got, want) {
+		t.Fatalf("mergeRoutingRules() = %v, want %v", got, want)
+	}
+
+	splitInline, splitAttached := splitRoutingRules(merged)
+	if got, want := routingRuleDisplayNames(splitInline), []string{"b", "a"}; !slices.Equal(got, want) {
+		t.Errorf("splitRoutingRules() inline = %v, want %v", got, want)
+	}
+	if got, want := routingRuleDisplayNames(splitAttached), []string{"ten", "twenty", "thirty"}; !slices.Equal(got, want) {
+		t.Errorf("splitRoutingRules() attached = %v, want %v", got, want)
+	}
+}
+
+func TestUpdateRoutingResourceWithoutAttachedRules(t *testing.T) {
+	// Routings without attached rules are written as a whole, even if the API sends no ETag.
+	api, client := newFakeRoutingAPI(t, false, testRoutingRule("old", nil))
+
+	result, err := client.UpdateRoutingResource(context.Background(), "routing-1", testRoutingModel("first", "second"))
+	if err != nil {
+		t.Fatalf("UpdateRoutingResource() error = %v", err)
+	}
+
+	if got, want := routingRuleDisplayNames(result.Rules), []string{"first", "second"}; !slices.Equal(got, want) {
+		t.Errorf("UpdateRoutingResource() rules = %v, want %v", got, want)
+	}
+	if len(api.puts) != 1 || api.puts[0].Get("If-Match") != "" {
+		t.Errorf("expected a single PUT without If-Match, got %v", api.puts)
+	}
+}
+
+func TestUpdateRoutingResourceKeepsAttachedRules(t *testing.T) {
+	api, client := newFakeRoutingAPI(t, true,
+		testRoutingRule("old", nil),
+		testRoutingRule("twenty", testPriority(20)),
+		testRoutingRule("ten", testPriority(10)),
+	)
+	api.conflicts = 1
+
+	result, err := client.UpdateRoutingResource(context.Background(), "routing-1", testRoutingModel("new"))
+	if err != nil {
+		t.Fatalf("UpdateRoutingResource() error = %v", err)
+	}
+
+	if got, want := routingRuleDisplayNames(result.Rules), []string{"new", "ten", "twenty"}; !slices.Equal(got, want) {
+		t.Errorf("UpdateRoutingResource() rules = %v, want %v", got, want)
+	}
+
+	// The rejected write is retried with the ETag of the routing read again.
+	if len(api.puts) != 2 {
+		t.Fatalf("expected 2 PUTs, got %d", len(api.puts))
+	}
+	if first, second := api.puts[0].Get("If-Match"), api.puts[1].Get("If-Match"); first == "" || second == "" || first == second {
+		t.Errorf("expected PUTs with different If-Match headers, got %q and %q", first, second)
+	}
+}
+
+func TestCreateRoutingRuleResourceRequiresETag(t *testing.T) {
+	api, client := newFakeRoutingAPI(t, false, testRoutingRule("inline", nil))
+
+	_, err := client.CreateRoutingRuleResource(context.Background(), &RoutingRuleResourceModel{
+		RoutingId:   types.StringValue("routing-1"),
+		Priority:    types.Int64Value(10),
+		DisplayName: types.StringValue("attached"),
+	})
+	if err == nil || !strings.Contains(err.Error(), "ETag") {
+		t.Fatalf("CreateRoutingRuleResource() error = %v, want missing ETag error", err)
+	}
+	if len(api.puts) != 0 {
+		t.Errorf("expected no PUT, got %d", len(api.puts))
+	}
+}
+
+func TestCreateRoutingRuleResourceInsertsByPriority(t *testing.T) {
+	api, client := newFakeRoutingAPI(t, true,
+		testRoutingRule("inline", nil),
+		testRoutingRule("ten", testPriority(10)),
+		testRoutingRule("thirty", testPriority(30)),
+	)
+
+	rule, err := client.CreateRoutingRuleResource(context.Background(), &RoutingRuleResourceModel{
+		RoutingId:   types.StringValue("routing-1"),
+		Priority:    types.Int64Value(20),
+		DisplayName: types.StringValue("twenty"),
+	})
+	if err != nil {
+		t.Fatalf("CreateRoutingRuleResource() error = %v", err)
+	}
+	if *rule.DisplayName != "twenty" {
+		t.Errorf("CreateRoutingRuleResource() = %q, want %q", *rule.DisplayName, "twenty")
+	}
+
+	if got, want := routingRuleDisplayNames(api.routing.Rules), []string{"inline", "ten", "twenty", "thirty"}; !slices.Equal(got, want) {
+		t.Errorf("routing rules = %v, want %v", got, want)
+	}
+}
@@ -137,259 +137,10 @@ func (r *Routing) Schema(ctx context.Context, req resource.SchemaRequest, resp *
 				Required:            true,
 			},
 			"rules": schema.ListNestedAttribute{
-				Optional: true,
+				MarkdownDescription: "The rules of the routing, evaluated in the given order. Rules attached with the `allquiet_routing_rule` resource are evaluated after these rules and are not managed by this attribute.",
+				Optional:            true,
 				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"display_name": schema.StringAttribute{
-							MarkdownDescription: "The display name of the routing rule",
-							Optional:            true,
-						},
-						"conditions": schema.SingleNestedAttribute{
-							MarkdownDescription: "Settings for the schedule",
-							Required:            true,
-							Attributes: map[string]schema.Attribute{
-								"statuses": schema.ListAttribute{
-									Optional:            true,
-									MarkdownDescription: "Statuses. Possible values are: " + strings.Join(ValidStatuses, ", "),
-									ElementType:         types.StringType,
-									Validators: []validator.List{
-										listvalidator.ValueStringsAre(StatusValidator("Not a valid status")),
-									},
-								},
-								"severities": schema.ListAttribute{
-									Optional:            true,
-									MarkdownDescription: "Severeties. Possible values are: " + strings.Join(ValidSeverities, ", "),
-									ElementType:         types.StringType,
-									Validators: []validator.List{
-										listvalidator.ValueStringsAre(SeverityValidator("Not a valid severity")),
-									},
-								},
-								"integrations": schema.ListAttribute{
-									Optional:            true,
-									MarkdownDescription: "Integration IDs",
-									ElementType:         types.StringType,
-									Validators: []validator.List{
-										listvalidator.ValueStringsAre(GuidValidator("Not a valid GUID")),
-									},
-								},
-								"intents": schema.ListAttribute{
-									Optional:            true,
-									MarkdownDescription: "Intents. Possible values are: " + strings.Join(ValidIntents, ", "),
-									ElementType:         types.StringType,
-									Validators: []validator.List{
-										listvalidator.ValueStringsAre(IntentValidator("Not a valid intent")),
-									},
-								},
-								"labels": schema.ListAttribute{
-									Optional:            true,
-									MarkdownDescription: "Labels that must appear on the routing team and/or the source integration of the incident. Use labels_match_type to require all listed labels (AND) or any one (OR).",
-									ElementType:         types.StringType,
-								},
-								"labels_match_type": schema.StringAttribute{
-									Optional:            true,
-									MarkdownDescription: "How labels are combined when multiple are set. Possible values are: " + strings.Join(ValidAttributesMatchTypes, ", "),
-									Validators:          []validator.String{stringvalidator.OneOf(ValidAttributesMatchTypes...)},
-								},
-								"attributes_match_type": schema.StringAttribute{
-									Optional:            true,
-									MarkdownDescription: "The match type for the attributes. Possible values are: " + strings.Join(ValidAttributesMatchTypes, ", "),
-									Validators:          []validator.String{stringvalidator.OneOf(ValidAttributesMatchTypes...)},
-								},
-								"attributes": schema.ListNestedAttribute{
-									Optional: true,
-									NestedObject: schema.NestedAttributeObject{
-										Attributes: map[string]schema.Attribute{
-											"name": schema.StringAttribute{
-												MarkdownDescription: "The name of the attribute",
-												Required:            true,
-											},
-											"operator": schema.StringAttribute{
-												MarkdownDescription: "The operator. Possible values are: " + strings.Join(ValidOperators, ", "),
-												Required:            true,
-												Validators:          []validator.String{OperatorValidator("Not a valid operator")},
-											},
-											"value": schema.StringAttribute{
-												MarkdownDescription: "The value of the attribute to match with the operator against",
-												Optional:            true,
-											},
-										},
-									},
-								},
-								"date_restriction": schema.SingleNestedAttribute{
-									Optional: true,
-									Attributes: map[string]schema.Attribute{
-										"from": schema.StringAttribute{
-											Optional:    true,
-											Description: "Start date for the routing rule (RFC3339 format)",
-											Validators:  []validator.String{DateTimeValidator("Not a valid date")},
-										},
-										"until": schema.StringAttribute{
-											Optional:    true,
-											Description: "End date for the routing rule (RFC3339 format)",
-											Validators:  []validator.String{DateTimeValidator("Not a valid date")},
-										},
-									},
-								}, "schedule": schema.SingleNestedAttribute{
-									Optional: true,
-									Attributes: map[string]schema.Attribute{
-										"after": schema.StringAttribute{
-											Optional:    true,
-											Description: "Time after which the rule is active (HH:mm format)",
-											Validators:  []validator.String{TimeValidator("Not a valid time")},
-										},
-										"before": schema.StringAttribute{
-											Optional:    true,
-											Description: "Time before which the rule is active (HH:mm format)",
-											Validators:  []validator.String{TimeValidator("Not a valid time")},
-										},
-										"days_of_week": schema.ListAttribute{
-											Optional:    true,
-											ElementType: types.StringType,
-											Description: "Days of the week when the rule is active. Possible values are: " + strings.Join(ValidDaysOfWeek, ", "),
-											Validators: []validator.List{
-												listvalidator.ValueStringsAre(DaysOfWeekValidator("Not a valid day of week")),
-											},
-										},
-									},
-								},
-							},
-						},
-						"actions": schema.SingleNestedAttribute{
-							MarkdownDescription: "Settings for the schedule",
-							Optional:            true,
-							Attributes: map[string]schema.Attribute{
-								"assign_to_teams": schema.ListAttribute{
-									Optional:            true,
-									MarkdownDescription: "Will assign the incident to the specified teams.",
-									ElementType:         types.StringType,
-									Validators: []validator.List{
-										listvalidator.ValueStringsAre(GuidValidator("Not a valid GUID")),
-									},
-								},
-								"assign_to_teams_repeat_alerts": schema.BoolAttribute{
-									Optional:            true,
-									Computed:            true,
-									Default:             booldefault.StaticBool(true),
-									MarkdownDescription: "If true, notify all assigned users and teams, including those previously notified. If false, only newly assigned users/teams are notified.",
-								},
-								"discard": schema.BoolAttribute{
-									Optional:            true,
-									Default:             booldefault.StaticBool(false),
-									Computed:            true,
-									MarkdownDescription: "If true will discard and delete the incident",
-								},
-								"change_severity": schema.StringAttribute{
-									Optional:            true,
-									MarkdownDescription: "Will change the severity of the incident. Possible values are: " + strings.Join(ValidSeverities, ", "),
-									Validators:          []validator.String{SeverityValidator("Not a valid severity")},
-								},
-								"add_interaction": schema.StringAttribute{
-									Optional:            true,
-									MarkdownDescription: "Will add an interaction. For instance, you can auto resolve an incident by adding an interaction of intent 'Resolved'. Possible values are: " + strings.Join(ValidIntents, ", "),
-									Validators:          []validator.String{IntentValidator("Not a valid intent")},
-								},
-								"rule_flow_control": schema.StringAttribute{
-									Optional:            true,
-									Default:             stringdefault.StaticString("Continue"),
-									Computed:            true,
-									MarkdownDescription: "If 'Skip' will not evaluate further rules. Possible values are: " + strings.Join(ValidRuleFlowControl, ", "),
-									Validators:          []validator.String{RuleFlowValidator("Not a valid rule flow value")},
-								},
-								"delay_actions_in_minutes": schema.Int64Attribute{
-									Optional:            true,
-									MarkdownDescription: "Delay actions in minutes",
-								},
-								"affects_services": schema.ListAttribute{
-									Optional:            true,
-									MarkdownDescription: "Will affect the specified services. Only with add_interaction 'Affects'.",
-									ElementType:         types.StringType,
-									Validators: []validator.List{
-										listvalidator.ValueStringsAre(GuidValidator("Not a valid GUID")),
-									},
-								},
-								"forward_to_outbound_integrations": schema.ListAttribute{
-									Optional:            true,
-									MarkdownDescription: "Will forward to the specified outbound integrations. Only with add_interaction 'Forwarded'.",
-									ElementType:         types.StringType,
-									Validators: []validator.List{
-										listvalidator.ValueStringsAre(GuidValidator("Not a valid GUID")),
-									},
-								},
-								"set_attributes": schema.ListNestedAttribute{
-									Optional: true,
-									NestedObject: schema.NestedAttributeObject{
-										Attributes: map[string]schema.Attribute{
-											"name": schema.StringAttribute{
-												MarkdownDescription: "The name of the attribute",
-												Required:            true,
-											},
-											"value": schema.StringAttribute{
-												MarkdownDescription: "The value of the attribute",
-												Required:            true,
-											},
-											"is_image": schema.BoolAttribute{
-												Optional:            true,
-												Default:             booldefault.StaticBool(false),
-												Computed:            true,
-												MarkdownDescription: "If true will display the value as an image if it's a URL",
-											},
-											"hide_in_previews": schema.BoolAttribute{
-												Optional:            true,
-												Default:             booldefault.StaticBool(false),
-												Computed:            true,
-												MarkdownDescription: "If true will hide the value in previews",
-											},
-										},
-									},
-								},
-								"snooze_for_relative_in_minutes": schema.Int64Attribute{
-									Optional:            true,
-									MarkdownDescription: "Snooze for relative in minutes",
-								},
-								"snooze_until_absolute": schema.StringAttribute{
-									Optional:            true,
-									MarkdownDescription: "Snooze until absolute",
-									Validators:          []validator.String{TimeValidator("Not a valid time")},
-								},
-								"snooze_until_weekday_absolute": schema.StringAttribute{
-									Optional:            true,
-									MarkdownDescription: "Snooze until weekday absolute. Possible values are: " + strings.Join(ValidDaysOfWeek, ", "),
-									Validators:          []validator.String{DaysOfWeekValidator("Not a valid day of week")},
-								},
-							},
-						},
-						"channels": schema.SingleNestedAttribute{
-							MarkdownDescription: "Settings for the schedule",
-							Optional:            true,
-							Attributes: map[string]schema.Attribute{
-								"outbound_integrations": schema.ListAttribute{
-									Optional:            true,
-									MarkdownDescription: "Outbound integrations",
-									ElementType:         types.StringType,
-								},
-								"outbound_integrations_muted": schema.BoolAttribute{
-									Optional:            true,
-									Default:             booldefault.StaticBool(false),
-									Computed:            true,
-									MarkdownDescription: "If true will mute the outbound integrations",
-								},
-								"notification_channels": schema.ListAttribute{
-									Optional:            true,
-									MarkdownDescription: "Notification channels",
-									ElementType:         types.StringType,
-									Validators: []validator.List{
-										listvalidator.ValueStringsAre(NotificationChannelValidator("Not a valid channel")),
-									},
-								},
-								"notification_channels_muted": schema.BoolAttribute{
-									Optional:            true,
-									Default:             booldefault.StaticBool(false),
-									Computed:            true,
-									MarkdownDescription: "If true will mute the notification channels",
-								},
-							},
-						},
-					},
+					Attributes: routingRuleSchemaAttributes(),
 				},
 			},
 			"team_connection_settings": schema.SingleNestedAttribute{
@@ -415,6 +166,262 @@ func (r *Routing) Schema(ctx context.Context, req resource.SchemaRequest, resp *
 	}
 }
 
+// routingRuleSchemaAttributes returns the attributes of a single routing rule. They are shared
+// by the rules of the allquiet_routing resource and the allquiet_routing_rule resource.
+func routingRuleSchemaAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"display_name": schema.StringAttribute{
+			MarkdownDescription: "The display name of the routing rule",
+			Optional:            true,
+		},
+		"conditions": schema.SingleNestedAttribute{
+			MarkdownDescription: "Settings for the schedule",
+			Required:            true,
+			Attributes: map[string]schema.Attribute{
+				"statuses": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: "Statuses. Possible values are: " + strings.Join(ValidStatuses, ", "),
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(StatusValidator("Not a valid status")),
+					},
+				},
+				"severities": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: "Severeties. Possible values are: " + strings.Join(ValidSeverities, ", "),
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(SeverityValidator("Not a valid severity")),
+					},
+				},
+				"integrations": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: "Integration IDs",
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(GuidValidator("Not a valid GUID")),
+					},
+				},
+				"intents": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: "Intents. Possible values are: " + strings.Join(ValidIntents, ", "),
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(IntentValidator("Not a valid intent")),
+					},
+				},
+				"labels": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: "Labels that must appear on the routing team and/or the source integration of the incident. Use labels_match_type to require all listed labels (AND) or any one (OR).",
+					ElementType:         types.StringType,
+				},
+				"labels_match_type": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "How labels are combined when multiple are set. Possible values are: " + strings.Join(ValidAttributesMatchTypes, ", "),
+					Validators:          []validator.String{stringvalidator.OneOf(ValidAttributesMatchTypes...)},
+				},
+				"attributes_match_type": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "The match type for the attributes. Possible values are: " + strings.Join(ValidAttributesMatchTypes, ", "),
+					Validators:          []validator.String{stringvalidator.OneOf(ValidAttributesMatchTypes...)},
+				},
+				"attributes": schema.ListNestedAttribute{
+					Optional: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the attribute",
+								Required:            true,
+							},
+							"operator": schema.StringAttribute{
+								MarkdownDescription: "The operator. Possible values are: " + strings.Join(ValidOperators, ", "),
+								Required:            true,
+								Validators:          []validator.String{OperatorValidator("Not a valid operator")},
+							},
+							"value": schema.StringAttribute{
+								MarkdownDescription: "The value of the attribute to match with the operator against",
+								Optional:            true,
+							},
+						},
+					},
+				},
+				"date_restriction": schema.SingleNestedAttribute{
+					Optional: true,
+					Attributes: map[string]schema.Attribute{
+						"from": schema.StringAttribute{
+							Optional:    true,
+							Description: "Start date for the routing rule (RFC3339 format)",
+							Validators:  []validator.String{DateTimeValidator("Not a valid date")},
+						},
+						"until": schema.StringAttribute{
+							Optional:    true,
+							Description: "End date for the routing rule (RFC3339 format)",
+							Validators:  []validator.String{DateTimeValidator("Not a valid date")},
+						},
+					},
+				}, "schedule": schema.SingleNestedAttribute{
+					Optional: true,
+					Attributes: map[string]schema.Attribute{
+						"after": schema.StringAttribute{
+							Optional:    true,
+							Description: "Time after which the rule is active (HH:mm format)",
+							Validators:  []validator.String{TimeValidator("Not a valid time")},
+						},
+						"before": schema.StringAttribute{
+							Optional:    true,
+							Description: "Time before which the rule is active (HH:mm format)",
+							Validators:  []validator.String{TimeValidator("Not a valid time")},
+						},
+						"days_of_week": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "Days of the week when the rule is active. Possible values are: " + strings.Join(ValidDaysOfWeek, ", "),
+							Validators: []validator.List{
+								listvalidator.ValueStringsAre(DaysOfWeekValidator("Not a valid day of week")),
+							},
+						},
+					},
+				},
+			},
+		},
+		"actions": schema.SingleNestedAttribute{
+			MarkdownDescription: "Settings for the schedule",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"assign_to_teams": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: "Will assign the incident to the specified teams.",
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(GuidValidator("Not a valid GUID")),
+					},
+				},
+				"assign_to_teams_repeat_alerts": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(true),
+					MarkdownDescription: "If true, notify all assigned users and teams, including those previously notified. If false, only newly assigned users/teams are notified.",
+				},
+				"discard": schema.BoolAttribute{
+					Optional:            true,
+					Default:             booldefault.StaticBool(false),
+					Computed:            true,
+					MarkdownDescription: "If true will discard and delete the incident",
+				},
+				"change_severity": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Will change the severity of the incident. Possible values are: " + strings.Join(ValidSeverities, ", "),
+					Validators:          []validator.String{SeverityValidator("Not a valid severity")},
+				},
+				"add_interaction": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Will add an interaction. For instance, you can auto resolve an incident by adding an interaction of intent 'Resolved'. Possible values are: " + strings.Join(ValidIntents, ", "),
+					Validators:          []validator.String{IntentValidator("Not a valid intent")},
+				},
+				"rule_flow_control": schema.StringAttribute{
+					Optional:            true,
+					Default:             stringdefault.StaticString("Continue"),
+					Computed:            true,
+					MarkdownDescription: "If 'Skip' will not evaluate further rules. Possible values are: " + strings.Join(ValidRuleFlowControl, ", "),
+					Validators:          []validator.String{RuleFlowValidator("Not a valid rule flow value")},
+				},
+				"delay_actions_in_minutes": schema.Int64Attribute{
+					Optional:            true,
+					MarkdownDescription: "Delay actions in minutes",
+				},
+				"affects_services": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: "Will affect the specified services. Only with add_interaction 'Affects'.",
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(GuidValidator("Not a valid GUID")),
+					},
+				},
+				"forward_to_outbound_integrations": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: "Will forward to the specified outbound integrations. Only with add_interaction 'Forwarded'.",
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(GuidValidator("Not a valid GUID")),
+					},
+				},
+				"set_attributes": schema.ListNestedAttribute{
+					Optional: true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"name": schema.StringAttribute{
+								MarkdownDescription: "The name of the attribute",
+								Required:            true,
+							},
+							"value": schema.StringAttribute{
+								MarkdownDescription: "The value of the attribute",
+								Required:            true,
+							},
+							"is_image": schema.BoolAttribute{
+								Optional:            true,
+								Default:             booldefault.StaticBool(false),
+								Computed:            true,
+								MarkdownDescription: "If true will display the value as an image if it's a URL",
+							},
+							"hide_in_previews": schema.BoolAttribute{
+								Optional:            true,
+								Default:             booldefault.StaticBool(false),
+								Computed:            true,
+								MarkdownDescription: "If true will hide the value in previews",
+							},
+						},
+					},
+				},
+				"snooze_for_relative_in_minutes": schema.Int64Attribute{
+					Optional:            true,
+					MarkdownDescription: "Snooze for relative in minutes",
+				},
+				"snooze_until_absolute": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Snooze until absolute",
+					Validators:          []validator.String{TimeValidator("Not a valid time")},
+				},
+				"snooze_until_weekday_absolute": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Snooze until weekday absolute. Possible values are: " + strings.Join(ValidDaysOfWeek, ", "),
+					Validators:          []validator.String{DaysOfWeekValidator("Not a valid day of week")},
+				},
+			},
+		},
+		"channels": schema.SingleNestedAttribute{
+			MarkdownDescription: "Settings for the schedule",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"outbound_integrations": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: "Outbound integrations",
+					ElementType:         types.StringType,
+				},
+				"outbound_integrations_muted": schema.BoolAttribute{
+					Optional:            true,
+					Default:             booldefault.StaticBool(false),
+					Computed:            true,
+					MarkdownDescription: "If true will mute the outbound integrations",
+				},
+				"notification_channels": schema.ListAttribute{
+					Optional:            true,
+					MarkdownDescription: "Notification channels",
+					ElementType:         types.StringType,
+					Validators: []validator.List{
+						listvalidator.ValueStringsAre(NotificationChannelValidator("Not a valid channel")),
+					},
+				},
+				"notification_channels_muted": schema.BoolAttribute{
+					Optional:            true,
+					Default:             booldefault.StaticBool(false),
+					Computed:            true,
+					MarkdownDescription: "If true will mute the notification channels",
+				},
+			},
+		},
+	}
+}
+
 func (r *Routing) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -474,7 +481,8 @@ func (r *Routing) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 	}
 
 	if routingResponse == nil {
-		resp.Diagnostics.AddError("Client Error", "Unable to get routing resource, got nil response")
+		tflog.Warn(ctx, fmt.Sprintf("routing %s no longer exists, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -536,7 +544,8 @@ func mapRoutingResponseToModel(ctx context.Context, response *routingResponse, d
 	data.Id = types.StringValue(response.Id)
 	data.DisplayName = types.StringValue(response.DisplayName)
 	data.TeamId = types.StringValue(response.TeamId)
-	data.Rules = mapRoutingRuleResponseToModel(ctx, response.Rules)
+	inline, _ := splitRoutingRules(response.Rules)
+	data.Rules = mapRoutingRuleResponseToModel(ctx, inline)
 	data.TeamConnectionSettings = MapTeamConnectionSettingsResponseToModel(ctx, response.TeamConnectionSettings)
 }
 
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+)
+
+func mapRoutingRuleResourceRequest(data *RoutingRuleResourceModel) routingRule {
+	rule := mapRoutingRule(RoutingRuleModel{
+		DisplayName: data.DisplayName,
+		Conditions:  data.Conditions,
+		Actions:     data.Actions,
+		Channels:    data.Channels,
+	})
+	rule.Priority = data.Priority.ValueInt64Pointer()
+	return rule
+}
+
+func (c *AllQuietAPIClient) CreateRoutingRuleResource(ctx context.Context, data *RoutingRuleResourceModel) (*routingRule, error) {
+	routingId := data.RoutingId.ValueString()
+	priority := data.Priority.ValueInt64()
+
+	result, err := c.modifyRoutingResource(ctx, routingId, func(routing *routingCreateRequest) error {
+		inline, attached := splitRoutingRules(routing.Rules)
+		if findRoutingRuleByPriority(attached, priority) != nil {
+			return fmt.Errorf("routing %s already has a rule with priority %d", routingId, priority)
+		}
+
+		routing.Rules = mergeRoutingRules(inline, append(attached, mapRoutingRuleResourceRequest(data)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findRoutingRuleInResponse(result, priority)
+}
+
+func (c *AllQuietAPIClient) UpdateRoutingRuleResource(ctx context.Context, data *RoutingRuleResourceModel) (*routingRule, error) {
+	routingId := data.RoutingId.ValueString()
+	priority := data.Priority.ValueInt64()
+
+	result, err := c.modifyRoutingResource(ctx, routingId, func(routing *routingCreateRequest) error {
+		inline, attached := splitRoutingRules(routing.Rules)
+		if findRoutingRuleByPriority(attached, priority) == nil {
+			return fmt.Errorf("routing %s has no rule with priority %d", routingId, priority)
+		}
+
+		attached = removeRoutingRuleByPriority(attached, priority)
+		routing.Rules = mergeRoutingRules(inline, append(attached, mapRoutingRuleResourceRequest(data)))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findRoutingRuleInResponse(result, priority)
+}
+
+func (c *AllQuietAPIClient) DeleteRoutingRuleResource(ctx context.Context, routingId string, priority int64) error {
+	routing, err := c.GetRoutingResource(ctx, routingId)
+	if err != nil {
+		return err
+	}
+
+	// The rule is gone together with its routing
+	if routing == nil || findRoutingRuleByPriority(routing.Rules, priority) == nil {
+		return nil
+	}
+
+	_, err = c.modifyRoutingResource(ctx, routingId, func(routing *routingCreateRequest) error {
+		routing.Rules = removeRoutingRuleByPriority(routing.Rules, priority)
+		return nil
+	})
+	return err
+}
+
+// GetRoutingRuleResource returns the attached rule with the given priority. Returns nil if the
+// routing or the rule does not exist.
+func (c *AllQuietAPIClient) GetRoutingRuleResource(ctx context.Context, routingId string, priority int64) (*routingRule, error) {
+	routing, err := c.GetRoutingResource(ctx, routingId)
+	if err != nil || routing == nil {
+		return nil, err
+	}
+
+	return findRoutingRuleByPriority(routing.Rules, priority), nil
+}
+
+func findRoutingRuleInResponse(routing *routingResponse, priority int64) (*routingRule, error) {
+	rule := findRoutingRuleByPriority(routing.Rules, priority)
+	if rule == nil {
+		return nil, fmt.Errorf("routing %s does not contain the rule with priority %d after the update", routing.Id, priority)
+	}
+	return rule, nil
+}
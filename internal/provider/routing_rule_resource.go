@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RoutingRule{}
+var _ resource.ResourceWithImportState = &RoutingRule{}
+
+func NewRoutingRule() resource.Resource {
+	return &RoutingRule{}
+}
+
+// RoutingRule defines the resource implementation.
+type RoutingRule struct {
+	client *AllQuietAPIClient
+}
+
+// RoutingRuleResourceModel describes the resource data model.
+type RoutingRuleResourceModel struct {
+	Id          types.String                `tfsdk:"id"`
+	RoutingId   types.String                `tfsdk:"routing_id"`
+	Priority    types.Int64                 `tfsdk:"priority"`
+	DisplayName types.String                `tfsdk:"display_name"`
+	Conditions  *RoutingRuleConditionsModel `tfsdk:"conditions"`
+	Actions     *RoutingRuleActionsModel    `tfsdk:"actions"`
+	Channels    *RoutingRuleChannelsModel   `tfsdk:"channels"`
+}
+
+func (r *RoutingRule) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_routing_rule"
+}
+
+func (r *RoutingRule) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := routingRuleSchemaAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed:            true,
+		MarkdownDescription: "Id of the rule in the format `<routing_id>/<priority>`",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["routing_id"] = schema.StringAttribute{
+		MarkdownDescription: "The id of the routing the rule is attached to",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["priority"] = schema.Int64Attribute{
+		MarkdownDescription: "The priority of the rule. Attached rules are evaluated after the inline `rules` of the routing, in ascending order of their priority. Each priority can only be used once per routing. Leave gaps (e.g. 10, 20, 30) to insert rules later without renumbering. Changing the priority replaces the rule.",
+		Required:            true,
+		Validators: []validator.Int64{
+			int64validator.AtLeast(0),
+		},
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The routing rule resource attaches a single rule to an existing routing. This allows different modules to own their rules of a shared routing. The provider merges the rule into the routing with a read-modify-write cycle and retries if the routing was changed concurrently. Writes fail if the API does not return an ETag for the routing or does not persist the priority of attached rules, rather than risking lost updates. Once a rule is attached, updates of the `allquiet_routing` use the same cycle to keep the attached rules, while routings without attached rules are written as before.",
+
+		Attributes: attributes,
+	}
+}
+
+func (r *RoutingRule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RoutingRule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RoutingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleResponse, err := r.client.CreateRoutingRuleResource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create routing rule resource, got error: %s", err))
+		return
+	}
+
+	mapRoutingRuleResourceResponseToModel(ctx, data.RoutingId.ValueString(), ruleResponse, &data)
+
+	tflog.Trace(ctx, "created routing rule resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoutingRule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RoutingRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleResponse, err := r.client.GetRoutingRuleResource(ctx, data.RoutingId.ValueString(), data.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get routing rule resource, got error: %s", err))
+		return
+	}
+
+	if ruleResponse == nil {
+		tflog.Warn(ctx, fmt.Sprintf("routing rule %s no longer exists, removing it from state", data.Id.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	mapRoutingRuleResourceResponseToModel(ctx, data.RoutingId.ValueString(), ruleResponse, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoutingRule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RoutingRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleResponse, err := r.client.UpdateRoutingRuleResource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update routing rule resource, got error: %s", err))
+		return
+	}
+
+	mapRoutingRuleResourceResponseToModel(ctx, data.RoutingId.ValueString(), ruleResponse, &data)
+
+	tflog.Trace(ctx, "updated routing rule resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RoutingRule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RoutingRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRoutingRuleResource(ctx, data.RoutingId.ValueString(), data.Priority.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete routing rule resource, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted routing rule resource")
+}
+
+func (r *RoutingRule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	routingId, priority, err := parseRoutingRuleId(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("routing_id"), routingId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("priority"), priority)...)
+}
+
+func routingRuleId(routingId string, priority int64) string {
+	return fmt.Sprintf("%s/%d", routingId, priority)
+}
+
+func parseRoutingRuleId(id string) (string, int64, error) {
+	routingId, priorityString, found := strings.Cut(id, "/")
+	if !found || routingId == "" {
+		return "", 0, fmt.Errorf("expected import identifier with format <routing_id>/<priority>, got: %q", id)
+	}
+
+	priority, err := strconv.ParseInt(priorityString, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("expected import identifier with format <routing_id>/<priority>, got: %q", id)
+	}
+
+	return routingId, priority, nil
+}
+
+func mapRoutingRuleResourceResponseToModel(ctx context.Context, routingId string, rule *routingRule, data *RoutingRuleResourceModel) {
+	data.Id = types.StringValue(routingRuleId(routingId, *rule.Priority))
+	data.RoutingId = types.StringValue(routingId)
+	data.Priority = types.Int64PointerValue(rule.Priority)
+	data.DisplayName = types.StringPointerValue(rule.DisplayName)
+	data.Conditions = mapRoutingRuleConditionsResponseToModel(ctx, rule.Conditions)
+	data.Actions = mapRoutingRuleActionsResponseToModel(ctx, rule.Actions)
+	data.Channels = mapRoutingRuleChannelsResponseToModel(ctx, rule.Channels)
+}
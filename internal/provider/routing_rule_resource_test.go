@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoutingRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoutingRuleResourceConfig("Critical to Test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_routing_rule.first", "priority", "10"),
+					resource.TestCheckResourceAttr("allquiet_routing_rule.first", "display_name", "Critical to Test"),
+					resource.TestCheckResourceAttr("allquiet_routing_rule.first", "conditions.severities.0", "Critical"),
+					resource.TestCheckResourceAttr("allquiet_routing_rule.second", "priority", "20"),
+					resource.TestCheckResourceAttr("allquiet_routing_rule.second", "channels.notification_channels.0", "VoiceCall"),
+					resource.TestCheckResourceAttr("allquiet_routing.test", "rules.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_routing_rule.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRoutingRuleResourceConfig("Critical to Test Updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_routing_rule.first", "display_name", "Critical to Test Updated"),
+					resource.TestCheckResourceAttr("allquiet_routing.test", "rules.#", "1"),
+				),
+			},
+			// Duplicate priority testing
+			{
+				Config:      testAccRoutingRuleResourceConfig("Critical to Test Updated") + testAccRoutingRuleResourceDuplicateConfig(),
+				ExpectError: regexp.MustCompile(`already has a rule with priority 10`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRoutingRuleResourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRoutingRuleResourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_routing_rule.payments_critical", "priority", "10"),
+					resource.TestCheckResourceAttr("allquiet_routing_rule.platform_fallback", "priority", "100"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_routing_rule.payments_critical",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRoutingRuleResourceConfig(display_name string) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "root" {
+  display_name = "Root"
+}
+
+resource "allquiet_team" "test" {
+  display_name = "Test"
+}
+
+resource "allquiet_routing" "test" {
+  display_name = "Routing with attached rules"
+  team_id      = allquiet_team.root.id
+  rules = [
+    {
+      conditions = {
+        statuses = ["Open"]
+      }
+      actions = {
+        assign_to_teams = [allquiet_team.root.id]
+      }
+    }
+  ]
+}
+
+resource "allquiet_routing_rule" "first" {
+  routing_id   = allquiet_routing.test.id
+  priority     = 10
+  display_name = %[1]q
+  conditions = {
+    severities = ["Critical"]
+  }
+  actions = {
+    assign_to_teams = [allquiet_team.test.id]
+  }
+}
+
+resource "allquiet_routing_rule" "second" {
+  routing_id = allquiet_routing.test.id
+  priority   = 20
+  conditions = {
+    severities = ["Warning"]
+  }
+  channels = {
+    notification_channels = ["VoiceCall"]
+  }
+}
+`, display_name)
+}
+
+func testAccRoutingRuleResourceDuplicateConfig() string {
+	return `
+resource "allquiet_routing_rule" "duplicate" {
+  routing_id = allquiet_routing.test.id
+  priority   = 10
+  conditions = {
+    severities = ["Minor"]
+  }
+}
+`
+}
+
+func testAccRoutingRuleResourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/resources/allquiet_routing_rule/resource.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return RandomizeExample(string(dat))
+}
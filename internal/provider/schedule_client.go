@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// scheduleRef is the content of the ref attribute of the allquiet_schedule data source. There is no
+// schedule object in the API, so the reference carries the definition of the schedule. It is expanded into
+// the escalation tiers of every team referencing it.
+type scheduleRef struct {
+	DisplayName        *string             `json:"displayName"`
+	ScheduleSettings   *scheduleSettings   `json:"scheduleSettings"`
+	RotationSettings   *rotationSettings   `json:"rotationSettings"`
+	RoundRobinSettings *roundRobinSettings `json:"roundRobinSettings"`
+	Rotations          []scheduleRotation  `json:"rotations"`
+}
+
+type scheduleRotation struct {
+	UserIds []string `json:"userIds"`
+}
+
+func mapScheduleRef(data *ScheduleDataSourceModel) (string, error) {
+	rotations := make([]scheduleRotation, len(data.Rotations))
+	for i, rotation := range data.Rotations {
+		userIds := make([]string, len(rotation.Members))
+		for j, member := range rotation.Members {
+			userIds[j] = member.UserId.ValueString()
+		}
+		rotations[i] = scheduleRotation{UserIds: userIds}
+	}
+
+	ref, err := json.Marshal(scheduleRef{
+		DisplayName:        data.DisplayName.ValueStringPointer(),
+		ScheduleSettings:   mapScheduleSettingsToRequest(data.ScheduleSettings),
+		RotationSettings:   mapRotationSettingsToRequest(data.RotationSettings),
+		RoundRobinSettings: mapRoundRobinSettingsToRequest(data.RoundRobinSettings),
+		Rotations:          rotations,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(ref), nil
+}
+
+// expandScheduleRefs returns the schedules the schedule_refs of the tiers expand to, keyed by ref. The
+// rotation members of shared schedules are users, which are resolved to their team memberships of the team.
+func (c *AllQuietAPIClient) expandScheduleRefs(ctx context.Context, data *TeamEscalationsModel) (map[string]*teamEscalationsSchedule, error) {
+	return c.expandScheduleRefsOfTeam(ctx, data, false)
+}
+
+// expandScheduleRefsForRead is expandScheduleRefs, except that refs which cannot be expanded anymore, e.g.
+// because a user left the team, are left out and show up as drift.
+func (c *AllQuietAPIClient) expandScheduleRefsForRead(ctx context.Context, data *TeamEscalationsModel) (map[string]*teamEscalationsSchedule, error) {
+	return c.expandScheduleRefsOfTeam(ctx, data, true)
+}
+
+func (c *AllQuietAPIClient) expandScheduleRefsOfTeam(ctx context.Context, data *TeamEscalationsModel, skipInvalid bool) (map[string]*teamEscalationsSchedule, error) {
+	teamId := data.TeamId.ValueString()
+
+	var teamMembershipIds map[string]string
+	expanded := map[string]*teamEscalationsSchedule{}
+	for _, tier := range data.EscalationTiers {
+		refs := ListToStringArray(tier.ScheduleRefs)
+		if refs == nil {
+			continue
+		}
+
+		for _, ref := range *refs {
+			if teamMembershipIds == nil {
+				var err error
+				teamMembershipIds, err = c.getTeamMembershipIdsByUserId(ctx, teamId)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			schedule, err := expandScheduleRef(ref, teamId, teamMembershipIds)
+			if err != nil {
+				if skipInvalid {
+					continue
+				}
+				return nil, err
+			}
+			expanded[ref] = schedule
+		}
+	}
+
+	return expanded, nil
+}
+
+// appendExpandedScheduleRefs appends the expanded schedule_refs to the schedules of the tiers of the request.
+func appendExpandedScheduleRefs(data *TeamEscalationsModel, request *teamEscalationsCreateRequest, expandedRefs map[string]*teamEscalationsSchedule) {
+	for i, tier := range data.EscalationTiers {
+		refs := ListToStringArray(tier.ScheduleRefs)
+		if refs == nil {
+			continue
+		}
+
+		for _, ref := range *refs {
+			if schedule, ok := expandedRefs[ref]; ok {
+				request.EscalationTiers[i].Schedules = append(request.EscalationTiers[i].Schedules, *schedule)
+			}
+		}
+	}
+}
+
+func (c *AllQuietAPIClient) getTeamMembershipIdsByUserId(ctx context.Context, teamId string) (map[string]string, error) {
+	memberships, err := c.GetTeamMembersResource(ctx, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	teamMembershipIds := make(map[string]string, len(memberships))
+	for _, membership := range memberships {
+		teamMembershipIds[membership.UserId] = membership.Id
+	}
+	return teamMembershipIds, nil
+}
+
+// scheduleMatchesExpandedRef reports whether a schedule of the API is the expansion of a schedule ref. Values
+// not set in the expansion are ignored, so defaults filled in by the API don't count as a change.
+func scheduleMatchesExpandedRef(schedule teamEscalationsSchedule, expanded *teamEscalationsSchedule) bool {
+	var actual, expected interface{}
+	if !remarshal(schedule, &actual) || !remarshal(expanded, &expected) {
+		return false
+	}
+	return jsonSubsetMatches(expected, actual)
+}
+
+func remarshal(value interface{}, target *interface{}) bool {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, target) == nil
+}
+
+// jsonSubsetMatches reports whether actual contains every non-null value of expected. Lists need to have
+// the same length and match element by element.
+func jsonSubsetMatches(expected interface{}, actual interface{}) bool {
+	switch expected := expected.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		actual, ok := actual.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range expected {
+			if !jsonSubsetMatches(value, actual[key]) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		actual, ok := actual.([]interface{})
+		if !ok || len(actual) != len(expected) {
+			return false
+		}
+		for i := range expected {
+			if !jsonSubsetMatches(expected[i], actual[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return expected == actual
+	}
+}
+
+func expandScheduleRef(ref string, teamId string, teamMembershipIds map[string]string) (*teamEscalationsSchedule, error) {
+	var parsed scheduleRef
+	err := json.Unmarshal([]byte(ref), &parsed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule reference, use the ref attribute of an allquiet_schedule data source: %s", err)
+	}
+
+	rotations := make([]teamEscalationsRotation, len(parsed.Rotations))
+	for i, rotation := range parsed.Rotations {
+		members := make([]teamEscalationsRotationMember, len(rotation.UserIds))
+		for j, userId := range rotation.UserIds {
+			teamMembershipId, ok := teamMembershipIds[userId]
+			if !ok {
+				if parsed.DisplayName != nil {
+					return nil, fmt.Errorf("user %s of schedule %q is not a member of team %s", userId, *parsed.DisplayName, teamId)
+				}
+				return nil, fmt.Errorf("user %s of a schedule is not a member of team %s", userId, teamId)
+			}
+			members[j] = teamEscalationsRotationMember{TeamMembershipId: teamMembershipId}
+		}
+		rotations[i] = teamEscalationsRotation{Members: members}
+	}
+
+	return &teamEscalationsSchedule{
+		DisplayName:        parsed.DisplayName,
+		ScheduleSettings:   parsed.ScheduleSettings,
+		RotationSettings:   parsed.RotationSettings,
+		RoundRobinSettings: parsed.RoundRobinSettings,
+		Rotations:          rotations,
+	}, nil
+}
@@ -0,0 +1,250 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ScheduleDataSource{}
+
+func NewScheduleDataSource() datasource.DataSource {
+	return &ScheduleDataSource{}
+}
+
+// ScheduleDataSource defines the data source implementation. It doesn't call the API, the ref is computed
+// from the configuration.
+type ScheduleDataSource struct{}
+
+// ScheduleDataSourceModel describes the data source data model.
+type ScheduleDataSourceModel struct {
+	DisplayName        types.String                            `tfsdk:"display_name"`
+	ScheduleSettings   *TeamEscalationsScheduleSettingsModel   `tfsdk:"schedule_settings"`
+	RotationSettings   *TeamEscalationsRotationSettingsModel   `tfsdk:"rotation_settings"`
+	RoundRobinSettings *TeamEscalationsRoundRobinSettingsModel `tfsdk:"round_robin_settings"`
+	Rotations          []ScheduleRotationModel                 `tfsdk:"rotations"`
+	Ref                types.String                            `tfsdk:"ref"`
+}
+
+type ScheduleRotationModel struct {
+	Members []ScheduleRotationMemberModel `tfsdk:"members"`
+}
+
+type ScheduleRotationMemberModel struct {
+	UserId types.String `tfsdk:"user_id"`
+}
+
+func (d *ScheduleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+func (d *ScheduleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The schedule data source defines a rotation once, so it can be shared by the escalation tiers of several teams through `schedule_refs` of `allquiet_team_escalations`. " +
+			"There is no schedule object in All Quiet and the data source doesn't call the API: its `ref` is computed from the configuration and expanded into the escalation tiers of every referencing team at apply time, so changing the schedule updates all of these teams. " +
+			"Rotation members are users instead of team memberships, and need to be members of every referencing team.",
+
+		Attributes: map[string]schema.Attribute{
+			"display_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Optional display name of the schedule.",
+			},
+			"rotations": schema.ListNestedAttribute{
+				Required: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"members": schema.ListNestedAttribute{
+							Required: true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"user_id": schema.StringAttribute{
+										Required:            true,
+										MarkdownDescription: "Id of the user",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"schedule_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for the schedule",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"start": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Start time of the schedule. Format: HH:mm",
+						DeprecationMessage:  "Use weekly_schedules instead",
+						Validators:          []validator.String{TimeValidator("Not a valid time")},
+					},
+					"end": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "End time of the schedule. Format: HH:mm",
+						DeprecationMessage:  "Use weekly_schedules instead",
+						Validators:          []validator.String{TimeValidator("Not a valid time")},
+					},
+					"selected_days": schema.ListAttribute{
+						Optional:            true,
+						DeprecationMessage:  "Use weekly_schedules instead",
+						MarkdownDescription: "Selected days of the week. Possible values are: " + strings.Join(ValidDaysOfWeek, ", "),
+						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(DaysOfWeekValidator("Not a valid day of week")),
+						},
+					},
+					"weekly_schedules": schema.ListNestedAttribute{
+						Optional:            true,
+						MarkdownDescription: "Weekly schedules",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"selected_days": schema.ListAttribute{
+									Optional:            true,
+									MarkdownDescription: "Days of the week. Possible values are: " + strings.Join(ValidDaysOfWeek, ", "),
+									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.ValueStringsAre(DaysOfWeekValidator("Not a valid day of week")),
+									},
+								},
+								"from": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "From time of the time filter. Format: HH:mm",
+									Validators:          []validator.String{TimeValidator("Not a valid time")},
+								},
+								"until": schema.StringAttribute{
+									Optional:            true,
+									MarkdownDescription: "Until time of the time filter. Format: HH:mm",
+									Validators:          []validator.String{TimeValidator("Not a valid time")},
+								},
+							},
+						},
+					},
+					"effective_from": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "If sets, the schedule will be effective from the given date in ISO 8601 format",
+						Validators: []validator.String{stringvalidator.RegexMatches(
+							regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`),
+							"must contain ISO date matching the pattern '^\\d{4}-\\d{2}-\\d{2}$'",
+						)},
+					},
+					"effective_until": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "If sets, the schedule will be effective until the given date in ISO 8601 format",
+						Validators: []validator.String{stringvalidator.RegexMatches(
+							regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`),
+							"must contain ISO date matching the pattern '^\\d{4}-\\d{2}-\\d{2}$'",
+						)},
+					},
+				},
+			},
+			"round_robin_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for round robin alerting. When enabled, incidents are distributed evenly among available responders.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"round_robin_size": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Number of users to assign per incident when using round robin. Max 100.",
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+					},
+				},
+			},
+			"rotation_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings for the rotation",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"repeats": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "The rotation will repeat on the given interval. Possible values are: " + strings.Join(ValidRotationRepeats, ", "),
+						Validators:          []validator.String{stringvalidator.OneOf(ValidRotationRepeats...)},
+					},
+					"starts_on_day_of_week": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Starts on day of the week. Needs to be set if 'repeats' is not 'monthly'. Possible values are: " + strings.Join(ValidDaysOfWeek, ", "),
+						Validators:          []validator.String{DaysOfWeekValidator("Not a valid day of week")},
+					},
+					"starts_on_date_of_month": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Zero-based, so the first day of the month is 0. If set, starts on date of the month. Needs to be set if 'repeats' is 'monthly'",
+						Validators:          []validator.Int64{int64validator.Between(0, 30)},
+					},
+					"starts_on_time": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "If set, starts on time of day. Needs to be set if 'repeats' is 'custom' and 'custom_repeat_unit' is 'hours'. Format: HH:mm",
+						Validators:          []validator.String{TimeValidator("Not a valid time")},
+					},
+					"custom_repeat_unit": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "In what interval unit the rotation should repeat. Needs to be set if 'repeats' is 'custom'. Possible values are: " + strings.Join(ValidCustomRepeatUnits, ", "),
+						Validators:          []validator.String{stringvalidator.OneOf(ValidCustomRepeatUnits...)},
+					},
+					"custom_repeat_value": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "How often the rotation should repeat. Needs to be set if 'repeats' is 'custom'",
+						Validators:          []validator.Int64{int64validator.Between(1, 365)},
+					},
+					"effective_from": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "If sets, the rotation will be effective from the given date in ISO 8601 format",
+						Validators: []validator.String{stringvalidator.RegexMatches(
+							regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`),
+							"must contain ISO date matching the pattern '^\\d{4}-\\d{2}-\\d{2}$'",
+						)},
+						DeprecationMessage: "Use effective_from and effective_until in `schedule_settings` instead",
+					},
+					"rotation_mode": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The mode of the rotation. Possible values are: " + strings.Join(ValidRotationModes, ", "),
+						Validators:          []validator.String{stringvalidator.OneOf(ValidRotationModes...)},
+					},
+					"auto_rotation_size": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "The size of the rotation",
+						Validators:          []validator.Int64{int64validator.Between(1, 500)},
+					},
+				},
+			},
+			"ref": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Reference to use in `escalation_tiers[].schedule_refs` of `allquiet_team_escalations`. It changes whenever the schedule changes.",
+			},
+		},
+	}
+}
+
+func (d *ScheduleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ScheduleDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ref, err := mapScheduleRef(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", err))
+		return
+	}
+	data.Ref = types.StringValue(ref)
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduleDataSource(t *testing.T) {
+	email := fmt.Sprintf("acceptance-tests+%s@allquiet.app", uuid.New().String())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccScheduleDataSourceConfig(email, "08:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.allquiet_schedule.test", "ref"),
+					resource.TestCheckResourceAttrPair("allquiet_team_escalations.test", "escalation_tiers.0.schedule_refs.0", "data.allquiet_schedule.test", "ref"),
+					resource.TestCheckResourceAttr("allquiet_team_escalations.test", "escalation_tiers.0.schedules.#", "0"),
+				),
+			},
+			// Update and Read testing: changing the schedule updates the referencing team
+			{
+				Config: testAccScheduleDataSourceConfig(email, "09:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.allquiet_schedule.test", "schedule_settings.weekly_schedules.0.from", "09:00"),
+					resource.TestCheckResourceAttrPair("allquiet_team_escalations.test", "escalation_tiers.0.schedule_refs.0", "data.allquiet_schedule.test", "ref"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccScheduleDataSourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccScheduleDataSourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.allquiet_schedule.eu_follow_the_sun_primary", "rotations.#", "2"),
+					resource.TestCheckResourceAttrPair("allquiet_team_escalations.payments", "escalation_tiers.0.schedule_refs.0", "data.allquiet_schedule.eu_follow_the_sun_primary", "ref"),
+					resource.TestCheckResourceAttrPair("allquiet_team_escalations.checkout", "escalation_tiers.0.schedule_refs.0", "data.allquiet_schedule.eu_follow_the_sun_primary", "ref"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccScheduleDataSourceConfig(email string, from string) string {
+	return fmt.Sprintf(`
+resource "allquiet_user" "test" {
+  display_name = "Schedule Test"
+  email        = %[1]q
+}
+
+resource "allquiet_team" "test" {
+  display_name = "Schedule Test"
+}
+
+resource "allquiet_team_membership" "test" {
+  team_id = allquiet_team.test.id
+  user_id = allquiet_user.test.id
+  role    = "Member"
+}
+
+data "allquiet_schedule" "test" {
+  display_name = "Shared"
+  schedule_settings = {
+    weekly_schedules = [
+      {
+        selected_days = ["mon", "tue", "wed", "thu", "fri"]
+        from          = %[2]q
+        until         = "17:00"
+      }
+    ]
+  }
+  rotations = [
+    {
+      members = [
+        { user_id = allquiet_user.test.id }
+      ]
+    }
+  ]
+}
+
+resource "allquiet_team_escalations" "test" {
+  team_id = allquiet_team.test.id
+  escalation_tiers = [
+    {
+      schedule_refs = [data.allquiet_schedule.test.ref]
+    }
+  ]
+  depends_on = [allquiet_team_membership.test]
+}
+`, email, from)
+}
+
+func testAccScheduleDataSourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/data-sources/allquiet_schedule/data-source.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return RandomizeExample(string(dat))
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type statusPageIncidentUpdateResponse struct {
+	Id           string    `json:"id"`
+	StatusPageId string    `json:"statusPageId"`
+	IncidentId   *string   `json:"incidentId"`
+	Title        string    `json:"title"`
+	Message      string    `json:"message"`
+	Status       string    `json:"status"`
+	Severity     *string   `json:"severity"`
+	ServiceIds   *[]string `json:"serviceIds"`
+}
+
+type statusPageIncidentUpdateCreateRequest struct {
+	StatusPageId string    `json:"statusPageId"`
+	IncidentId   *string   `json:"incidentId"`
+	Title        string    `json:"title"`
+	Message      string    `json:"message"`
+	Status       string    `json:"status"`
+	Severity     *string   `json:"severity"`
+	ServiceIds   *[]string `json:"serviceIds"`
+}
+
+func mapStatusPageIncidentUpdateCreateRequest(plan *StatusPageIncidentUpdateModel) *statusPageIncidentUpdateCreateRequest {
+	return &statusPageIncidentUpdateCreateRequest{
+		StatusPageId: plan.StatusPageId.ValueString(),
+		IncidentId:   plan.IncidentId.ValueStringPointer(),
+		Title:        plan.Title.ValueString(),
+		Message:      plan.Message.ValueString(),
+		Status:       plan.Status.ValueString(),
+		Severity:     plan.Severity.ValueStringPointer(),
+		ServiceIds:   ListToStringArray(plan.Services),
+	}
+}
+
+// publicSeverity returns how the severity is shown on the status page, following the
+// public_severity_mapping_* attributes of the status page. Unmapped severities are shown as they are.
+func publicSeverity(statusPage *statusPageResponse, severity *string) *string {
+	if severity == nil {
+		return nil
+	}
+
+	var mapping *string
+	switch *severity {
+	case "Minor":
+		mapping = statusPage.PublicSeverityMappingMinor
+	case "Warning":
+		mapping = statusPage.PublicSeverityMappingWarning
+	case "Critical":
+		mapping = statusPage.PublicSeverityMappingCritical
+	}
+
+	if mapping == nil || *mapping == "" {
+		return severity
+	}
+
+	return mapping
+}
+
+func (c *AllQuietAPIClient) CreateStatusPageIncidentUpdateResource(ctx context.Context, data *StatusPageIncidentUpdateModel) (*statusPageIncidentUpdateResponse, *statusPageResponse, error) {
+	reqBody := mapStatusPageIncidentUpdateCreateRequest(data)
+
+	statusPage, err := c.getStatusPageForPublishing(ctx, reqBody.StatusPageId, reqBody.ServiceIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	url := "/status-page-incident-updates"
+	httpResp, err := c.post(ctx, url, reqBody)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, nil, logErrorResponse(httpResp, nil)
+	}
+
+	var result statusPageIncidentUpdateResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &result, statusPage, nil
+}
+
+func (c *AllQuietAPIClient) DeleteStatusPageIncidentUpdateResource(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/status-page-incident-updates/%s", url.PathEscape(id))
+	httpResp, err := c.delete(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return logErrorResponse(httpResp, nil)
+	}
+
+	return nil
+}
+
+func (c *AllQuietAPIClient) UpdateStatusPageIncidentUpdateResource(ctx context.Context, id string, data *StatusPageIncidentUpdateModel) (*statusPageIncidentUpdateResponse, *statusPageResponse, error) {
+	reqBody := mapStatusPageIncidentUpdateCreateRequest(data)
+
+	statusPage, err := c.getStatusPageForPublishing(ctx, reqBody.StatusPageId, reqBody.ServiceIds)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	url := fmt.Sprintf("/status-page-incident-updates/%s", url.PathEscape(id))
+	httpResp, err := c.put(ctx, url, reqBody)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, nil, logErrorResponse(httpResp, nil)
+	}
+
+	var result statusPageIncidentUpdateResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &result, statusPage, nil
+}
+
+func (c *AllQuietAPIClient) GetStatusPageIncidentUpdateResource(ctx context.Context, id string) (*statusPageIncidentUpdateResponse, *statusPageResponse, error) {
+	url := fmt.Sprintf("/status-page-incident-updates/%s", url.PathEscape(id))
+	httpResp, err := c.get(ctx, url)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, nil, logErrorResponse(httpResp, nil)
+	}
+
+	var result statusPageIncidentUpdateResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	statusPage, err := c.GetStatusPageResource(ctx, result.StatusPageId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &result, statusPage, nil
+}
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StatusPageIncidentUpdate{}
+var _ resource.ResourceWithImportState = &StatusPageIncidentUpdate{}
+
+func NewStatusPageIncidentUpdate() resource.Resource {
+	return &StatusPageIncidentUpdate{}
+}
+
+// StatusPageIncidentUpdate defines the resource implementation.
+type StatusPageIncidentUpdate struct {
+	client *AllQuietAPIClient
+}
+
+// StatusPageIncidentUpdateModel describes the resource data model.
+type StatusPageIncidentUpdateModel struct {
+	Id             types.String `tfsdk:"id"`
+	StatusPageId   types.String `tfsdk:"status_page_id"`
+	IncidentId     types.String `tfsdk:"incident_id"`
+	Title          types.String `tfsdk:"title"`
+	Message        types.String `tfsdk:"message"`
+	Status         types.String `tfsdk:"status"`
+	Severity       types.String `tfsdk:"severity"`
+	Services       types.List   `tfsdk:"services"`
+	PublicSeverity types.String `tfsdk:"public_severity"`
+}
+
+func (r *StatusPageIncidentUpdate) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_incident_update"
+}
+
+func (r *StatusPageIncidentUpdate) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The `status_page_incident_update` resource publishes an incident update on a status page. The severity is shown publicly as configured by the `public_severity_mapping_*` attributes of the status page.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the status page the update is published to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"incident_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Id of the All Quiet incident the update belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Public title of the incident",
+			},
+			"message": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Public message of the update",
+			},
+			"status": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Public status of the incident. Possible values are: " + strings.Join(ValidStatusPageIncidentUpdateStatuses, ", "),
+				Validators: []validator.String{
+					stringvalidator.OneOf(ValidStatusPageIncidentUpdateStatuses...),
+				},
+			},
+			"severity": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Severity of the incident. Possible values are: " + strings.Join(ValidSeverities, ", "),
+				Validators:          []validator.String{SeverityValidator("Not a valid severity")},
+			},
+			"services": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: "Ids of the affected services. They need to be shown on the status page.",
+				ElementType:         types.StringType,
+			},
+			"public_severity": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The severity as shown on the status page, following its `public_severity_mapping_*` attributes",
+			},
+		},
+	}
+}
+
+func (r *StatusPageIncidentUpdate) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AllQuietAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StatusPageIncidentUpdate) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StatusPageIncidentUpdateModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResponse, statusPage, err := r.client.CreateStatusPageIncidentUpdateResource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create status page incident update resource, got error: %s", err))
+		return
+	}
+
+	mapStatusPageIncidentUpdateResponseToModel(ctx, updateResponse, statusPage, &data)
+
+	tflog.Trace(ctx, "created status page incident update resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageIncidentUpdate) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StatusPageIncidentUpdateModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResponse, statusPage, err := r.client.GetStatusPageIncidentUpdateResource(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get status page incident update resource, got error: %s", err))
+		return
+	}
+
+	if updateResponse == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to get status page incident update resource, got nil response")
+		return
+	}
+
+	mapStatusPageIncidentUpdateResponseToModel(ctx, updateResponse, statusPage, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageIncidentUpdate) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StatusPageIncidentUpdateModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateResponse, statusPage, err := r.client.UpdateStatusPageIncidentUpdateResource(ctx, data.Id.ValueString(), &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update status page incident update resource, got error: %s", err))
+		return
+	}
+
+	mapStatusPageIncidentUpdateResponseToModel(ctx, updateResponse, statusPage, &data)
+
+	tflog.Trace(ctx, "updated status page incident update resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageIncidentUpdate) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StatusPageIncidentUpdateModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteStatusPageIncidentUpdateResource(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete status page incident update resource, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted status page incident update resource")
+}
+
+func (r *StatusPageIncidentUpdate) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func mapStatusPageIncidentUpdateResponseToModel(ctx context.Context, response *statusPageIncidentUpdateResponse, statusPage *statusPageResponse, data *StatusPageIncidentUpdateModel) {
+	data.Id = types.StringValue(response.Id)
+	data.StatusPageId = types.StringValue(response.StatusPageId)
+	data.IncidentId = types.StringPointerValue(response.IncidentId)
+	data.Title = types.StringValue(response.Title)
+	data.Message = types.StringValue(response.Message)
+	data.Status = types.StringValue(response.Status)
+	data.Severity = types.StringPointerValue(response.Severity)
+	data.Services = MapNullableList(ctx, response.ServiceIds)
+	data.PublicSeverity = types.StringPointerValue(publicSeverity(statusPage, response.Severity))
+}
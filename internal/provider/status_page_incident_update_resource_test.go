@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatusPageIncidentUpdateResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStatusPageIncidentUpdateResourceConfig("Investigating", "Critical"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_status_page_incident_update.test", "status", "Investigating"),
+					resource.TestCheckResourceAttr("allquiet_status_page_incident_update.test", "public_severity", "Major Outage"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_status_page_incident_update.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing: unmapped severities are shown as they are
+			{
+				Config: testAccStatusPageIncidentUpdateResourceConfig("Monitoring", "Warning"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_status_page_incident_update.test", "status", "Monitoring"),
+					resource.TestCheckResourceAttr("allquiet_status_page_incident_update.test", "public_severity", "Warning"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccStatusPageIncidentUpdateResourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStatusPageIncidentUpdateResourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_status_page_incident_update.payment_outage", "public_severity", "Major Outage"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_status_page_incident_update.payment_outage",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccStatusPageIncidentUpdateResourceConfig(status string, severity string) string {
+	return fmt.Sprintf(`
+resource "allquiet_service" "test" {
+  display_name = "Incident Update Test"
+  public_title = "Incident Update Test"
+}
+
+resource "allquiet_status_page" "test" {
+  display_name                     = "Incident Update Test"
+  public_title                     = "Incident Update Test"
+  history_in_days                  = 30
+  disable_public_subscription      = true
+  public_severity_mapping_critical = "Major Outage"
+  service_groups = [
+    {
+      public_display_name = "Services"
+      services            = [allquiet_service.test.id]
+    }
+  ]
+}
+
+resource "allquiet_status_page_incident_update" "test" {
+  status_page_id = allquiet_status_page.test.id
+  title          = "Test incident"
+  message        = "Test message"
+  status         = %[1]q
+  severity       = %[2]q
+  services       = [allquiet_service.test.id]
+}
+`, status, severity)
+}
+
+func testAccStatusPageIncidentUpdateResourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/resources/allquiet_status_page_incident_update/resource.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return RandomizeExample(string(dat))
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type statusPageMaintenanceResponse struct {
+	Id           string    `json:"id"`
+	StatusPageId string    `json:"statusPageId"`
+	Title        string    `json:"title"`
+	Message      *string   `json:"message"`
+	ServiceIds   *[]string `json:"serviceIds"`
+	Start        string    `json:"start"`
+	End          string    `json:"end"`
+	AutoComplete bool      `json:"autoComplete"`
+}
+
+type statusPageMaintenanceCreateRequest struct {
+	StatusPageId string    `json:"statusPageId"`
+	Title        string    `json:"title"`
+	Message      *string   `json:"message"`
+	ServiceIds   *[]string `json:"serviceIds"`
+	Start        string    `json:"start"`
+	End          string    `json:"end"`
+	AutoComplete bool      `json:"autoComplete"`
+}
+
+func mapStatusPageMaintenanceCreateRequest(plan *StatusPageMaintenanceModel) *statusPageMaintenanceCreateRequest {
+	return &statusPageMaintenanceCreateRequest{
+		StatusPageId: plan.StatusPageId.ValueString(),
+		Title:        plan.Title.ValueString(),
+		Message:      plan.Message.ValueStringPointer(),
+		ServiceIds:   ListToStringArray(plan.Services),
+		Start:        plan.Start.ValueString(),
+		End:          plan.End.ValueString(),
+		AutoComplete: plan.AutoComplete.ValueBool(),
+	}
+}
+
+// getStatusPageForPublishing returns the status page an announcement is published to and makes sure the
+// affected services are shown on it.
+func (c *AllQuietAPIClient) getStatusPageForPublishing(ctx context.Context, statusPageId string, serviceIds *[]string) (*statusPageResponse, error) {
+	statusPage, err := c.GetStatusPageResource(ctx, statusPageId)
+	if err != nil {
+		return nil, err
+	}
+
+	if serviceIds == nil {
+		return statusPage, nil
+	}
+
+	var statusPageServiceIds []string
+	if statusPage.ServiceIds != nil {
+		statusPageServiceIds = append(statusPageServiceIds, *statusPage.ServiceIds...)
+	}
+	if statusPage.ServiceGroups != nil {
+		for _, serviceGroup := range *statusPage.ServiceGroups {
+			if serviceGroup.ServiceIds != nil {
+				statusPageServiceIds = append(statusPageServiceIds, *serviceGroup.ServiceIds...)
+			}
+		}
+	}
+
+	for _, serviceId := range *serviceIds {
+		if !slices.Contains(statusPageServiceIds, serviceId) {
+			return nil, fmt.Errorf("service %s is not shown on status page %s", serviceId, statusPageId)
+		}
+	}
+
+	return statusPage, nil
+}
+
+func warnIfMaintenancesHidden(statusPage *statusPageResponse, diagnostics *diag.Diagnostics) {
+	if statusPage.PublicHideMaintenances {
+		diagnostics.AddWarning(
+			"Maintenance not shown publicly",
+			fmt.Sprintf("Status page %s has public_hide_maintenances enabled, so the maintenance is not shown on the public page.", statusPage.Id),
+		)
+	}
+}
+
+func (c *AllQuietAPIClient) CreateStatusPageMaintenanceResource(ctx context.Context, data *StatusPageMaintenanceModel, diagnostics *diag.Diagnostics) (*statusPageMaintenanceResponse, error) {
+	reqBody := mapStatusPageMaintenanceCreateRequest(data)
+
+	statusPage, err := c.getStatusPageForPublishing(ctx, reqBody.StatusPageId, reqBody.ServiceIds)
+	if err != nil {
+		return nil, err
+	}
+
+	url := "/status-page-maintenances"
+	httpResp, err := c.post(ctx, url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, logErrorResponse(httpResp, nil)
+	}
+
+	var result statusPageMaintenanceResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	warnIfMaintenancesHidden(statusPage, diagnostics)
+
+	return &result, nil
+}
+
+func (c *AllQuietAPIClient) DeleteStatusPageMaintenanceResource(ctx context.Context, id string) error {
+	url := fmt.Sprintf("/status-page-maintenances/%s", url.PathEscape(id))
+	httpResp, err := c.delete(ctx, url)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return logErrorResponse(httpResp, nil)
+	}
+
+	return nil
+}
+
+func (c *AllQuietAPIClient) UpdateStatusPageMaintenanceResource(ctx context.Context, id string, data *StatusPageMaintenanceModel, diagnostics *diag.Diagnostics) (*statusPageMaintenanceResponse, error) {
+	reqBody := mapStatusPageMaintenanceCreateRequest(data)
+
+	statusPage, err := c.getStatusPageForPublishing(ctx, reqBody.StatusPageId, reqBody.ServiceIds)
+	if err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("/status-page-maintenances/%s", url.PathEscape(id))
+	httpResp, err := c.put(ctx, url, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, logErrorResponse(httpResp, nil)
+	}
+
+	var result statusPageMaintenanceResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	warnIfMaintenancesHidden(statusPage, diagnostics)
+
+	return &result, nil
+}
+
+func (c *AllQuietAPIClient) GetStatusPageMaintenanceResource(ctx context.Context, id string) (*statusPageMaintenanceResponse, error) {
+	url := fmt.Sprintf("/status-page-maintenances/%s", url.PathEscape(id))
+	httpResp, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, logErrorResponse(httpResp, nil)
+	}
+
+	var result statusPageMaintenanceResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
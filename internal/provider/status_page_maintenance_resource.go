@@ -0,0 +1,257 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AllQuietApp/terraform-provider-internal/internal/provider/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StatusPageMaintenance{}
+var _ resource.ResourceWithImportState = &StatusPageMaintenance{}
+var _ resource.ResourceWithValidateConfig = &StatusPageMaintenance{}
+
+func NewStatusPageMaintenance() resource.Resource {
+	return &StatusPageMaintenance{}
+}
+
+// StatusPageMaintenance defines the resource implementation.
+type StatusPageMaintenance struct {
+	client *AllQuietAPIClient
+}
+
+// StatusPageMaintenanceModel describes the resource data model.
+type StatusPageMaintenanceModel struct {
+	Id           types.String `tfsdk:"id"`
+	StatusPageId types.String `tfsdk:"status_page_id"`
+	Title        types.String `tfsdk:"title"`
+	Message      types.String `tfsdk:"message"`
+	Services     types.List   `tfsdk:"services"`
+	Start        types.String `tfsdk:"start"`
+	End          types.String `tfsdk:"end"`
+	AutoComplete types.Bool   `tfsdk:"auto_complete"`
+}
+
+func (r *StatusPageMaintenance) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_maintenance"
+}
+
+func (r *StatusPageMaintenance) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The `status_page_maintenance` resource announces a planned maintenance on a status page. If the status page has `public_hide_maintenances` enabled, the maintenance is created but not shown on the public page, and a warning is shown.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Id of the status page the maintenance is published to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Public title of the maintenance",
+			},
+			"message": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Public message of the maintenance",
+			},
+			"services": schema.ListAttribute{
+				Optional:            true,
+				MarkdownDescription: "Ids of the affected services. They need to be shown on the status page.",
+				ElementType:         types.StringType,
+			},
+			"start": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Start of the maintenance as UTC date time, e.g. `2025-01-31T18:00:00Z`",
+				Validators:          []validator.String{DateTimeValidator("Not a valid date")},
+			},
+			"end": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "End of the maintenance as UTC date time, e.g. `2025-01-31T20:00:00Z`. Needs to be after `start`.",
+				Validators:          []validator.String{DateTimeValidator("Not a valid date")},
+			},
+			"auto_complete": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "If true, the maintenance is marked as completed when `end` is reached.",
+			},
+		},
+	}
+}
+
+func (r *StatusPageMaintenance) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var start, end types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("start"), &start)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("end"), &end)...)
+	if resp.Diagnostics.HasError() || start.IsNull() || start.IsUnknown() || end.IsNull() || end.IsUnknown() {
+		return
+	}
+
+	// Invalid dates are reported by the attribute validators.
+	startTime, err := time.Parse(validators.DateTimeLayout, start.ValueString())
+	if err != nil {
+		return
+	}
+	endTime, err := time.Parse(validators.DateTimeLayout, end.ValueString())
+	if err != nil {
+		return
+	}
+
+	if !endTime.After(startTime) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("end (%s) of the maintenance needs to be after its start (%s)", end.ValueString(), start.ValueString()),
+		)
+	}
+}
+
+func (r *StatusPageMaintenance) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AllQuietAPIClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StatusPageMaintenance) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StatusPageMaintenanceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceResponse, err := r.client.CreateStatusPageMaintenanceResource(ctx, &data, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create status page maintenance resource, got error: %s", err))
+		return
+	}
+
+	mapStatusPageMaintenanceResponseToModel(ctx, maintenanceResponse, &data)
+
+	tflog.Trace(ctx, "created status page maintenance resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageMaintenance) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StatusPageMaintenanceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceResponse, err := r.client.GetStatusPageMaintenanceResource(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get status page maintenance resource, got error: %s", err))
+		return
+	}
+
+	if maintenanceResponse == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to get status page maintenance resource, got nil response")
+		return
+	}
+
+	mapStatusPageMaintenanceResponseToModel(ctx, maintenanceResponse, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageMaintenance) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data StatusPageMaintenanceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maintenanceResponse, err := r.client.UpdateStatusPageMaintenanceResource(ctx, data.Id.ValueString(), &data, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update status page maintenance resource, got error: %s", err))
+		return
+	}
+
+	mapStatusPageMaintenanceResponseToModel(ctx, maintenanceResponse, &data)
+
+	tflog.Trace(ctx, "updated status page maintenance resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StatusPageMaintenance) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StatusPageMaintenanceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteStatusPageMaintenanceResource(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete status page maintenance resource, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted status page maintenance resource")
+}
+
+func (r *StatusPageMaintenance) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func mapStatusPageMaintenanceResponseToModel(ctx context.Context, response *statusPageMaintenanceResponse, data *StatusPageMaintenanceModel) {
+	data.Id = types.StringValue(response.Id)
+	data.StatusPageId = types.StringValue(response.StatusPageId)
+	data.Title = types.StringValue(response.Title)
+	data.Message = types.StringPointerValue(response.Message)
+	data.Services = MapNullableList(ctx, response.ServiceIds)
+	data.Start = types.StringValue(response.Start)
+	data.End = types.StringValue(response.End)
+	data.AutoComplete = types.BoolValue(response.AutoComplete)
+}
@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccStatusPageMaintenanceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStatusPageMaintenanceResourceConfig("Database upgrade", "2030-01-16T02:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_status_page_maintenance.test", "title", "Database upgrade"),
+					resource.TestCheckResourceAttr("allquiet_status_page_maintenance.test", "auto_complete", "true"),
+					resource.TestCheckResourceAttr("allquiet_status_page_maintenance.test", "services.#", "1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_status_page_maintenance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccStatusPageMaintenanceResourceConfig("Database upgrade extended", "2030-01-16T04:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_status_page_maintenance.test", "title", "Database upgrade extended"),
+					resource.TestCheckResourceAttr("allquiet_status_page_maintenance.test", "end", "2030-01-16T04:00:00Z"),
+				),
+			},
+			// End before start testing
+			{
+				Config:      testAccStatusPageMaintenanceResourceConfig("Database upgrade extended", "2030-01-15T20:00:00Z"),
+				ExpectError: regexp.MustCompile(`needs to be after its start`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccStatusPageMaintenanceResourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStatusPageMaintenanceResourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_status_page_maintenance.database_upgrade", "title", "Database upgrade"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_status_page_maintenance.database_upgrade",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccStatusPageMaintenanceResourceConfig(title string, end string) string {
+	return fmt.Sprintf(`
+resource "allquiet_service" "test" {
+  display_name = "Maintenance Test"
+  public_title = "Maintenance Test"
+}
+
+resource "allquiet_status_page" "test" {
+  display_name                = "Maintenance Test"
+  public_title                = "Maintenance Test"
+  history_in_days             = 30
+  disable_public_subscription = true
+  service_groups = [
+    {
+      public_display_name = "Services"
+      services            = [allquiet_service.test.id]
+    }
+  ]
+}
+
+resource "allquiet_status_page_maintenance" "test" {
+  status_page_id = allquiet_status_page.test.id
+  title          = %[1]q
+  services       = [allquiet_service.test.id]
+  start          = "2030-01-15T22:00:00Z"
+  end            = %[2]q
+}
+`, title, end)
+}
+
+func testAccStatusPageMaintenanceResourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/resources/allquiet_status_page_maintenance/resource.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return RandomizeExample(string(dat))
+}
@@ -397,6 +397,7 @@ func (r *StatusPage) Schema(ctx context.Context, req resource.SchemaRequest, res
 			"time_zone_id": schema.StringAttribute{
 				MarkdownDescription: "The time zone id of the status page",
 				Optional:            true,
+				Validators:          []validator.String{TimeZoneValidator("Not a valid time zone id")},
 			},
 			"public_display_live_state_only": schema.BoolAttribute{
 				MarkdownDescription: "When true, the public page hides history, graphs, and uptime percentage and shows only the current operational state.",
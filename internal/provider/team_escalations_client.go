@@ -12,6 +12,7 @@ type teamEscalationsResponse struct {
 	Id              string
 	TeamId          string
 	EscalationTiers []teamEscalationsTier
+	TierSettings    *tierSettings
 }
 
 type teamEscalationsCreateRequest struct {
@@ -129,40 +130,10 @@ func mapTier(tier TeamEscalationsTierModel) *teamEscalationsTier {
 	for i, schedule := range tier.Schedules {
 
 		schedules[i] = teamEscalationsSchedule{
-			DisplayName: schedule.DisplayName.ValueStringPointer(),
-		}
-
-		if schedule.RoundRobinSettings != nil {
-			schedules[i].RoundRobinSettings = &roundRobinSettings{
-				RoundRobinSize: schedule.RoundRobinSettings.RoundRobinSize.ValueInt64Pointer(),
-			}
-		}
-
-		if schedule.ScheduleSettings != nil {
-			selectedDays := ListToStringArray(schedule.ScheduleSettings.SelectedDays)
-
-			schedules[i].ScheduleSettings = &scheduleSettings{
-				Start:           schedule.ScheduleSettings.Start.ValueStringPointer(),
-				End:             schedule.ScheduleSettings.End.ValueStringPointer(),
-				SelectedDays:    selectedDays,
-				WeeklySchedules: mapTeamEscalationsWeeklySchedulesToRequest(schedule.ScheduleSettings.WeeklySchedules),
-				EffectiveFrom:   schedule.ScheduleSettings.EffectiveFrom.ValueStringPointer(),
-				EffectiveUntil:  schedule.ScheduleSettings.EffectiveUntil.ValueStringPointer(),
-			}
-		}
-
-		if schedule.RotationSettings != nil {
-			schedules[i].RotationSettings = &rotationSettings{
-				Repeats:             schedule.RotationSettings.Repeats.ValueStringPointer(),
-				StartsOnDayOfWeek:   schedule.RotationSettings.StartsOnDayOfWeek.ValueStringPointer(),
-				StartsOnDateOfMonth: schedule.RotationSettings.StartsOnDateOfMonth.ValueInt64Pointer(),
-				StartsOnTime:        schedule.RotationSettings.StartsOnTime.ValueStringPointer(),
-				CustomRepeatUnit:    schedule.RotationSettings.CustomRepeatUnit.ValueStringPointer(),
-				CustomRepeatValue:   schedule.RotationSettings.CustomRepeatValue.ValueInt64Pointer(),
-				EffectiveFrom:       schedule.RotationSettings.EffectiveFrom.ValueStringPointer(),
-				RotationMode:        schedule.RotationSettings.RotationMode.ValueStringPointer(),
-				AutoRotationSize:    schedule.RotationSettings.AutoRotationSize.ValueInt64Pointer(),
-			}
+			DisplayName:        schedule.DisplayName.ValueStringPointer(),
+			ScheduleSettings:   mapScheduleSettingsToRequest(schedule.ScheduleSettings),
+			RotationSettings:   mapRotationSettingsToRequest(schedule.RotationSettings),
+			RoundRobinSettings: mapRoundRobinSettingsToRequest(schedule.RoundRobinSettings),
 		}
 
 		rotations := make([]teamEscalationsRotation, len(schedule.Rotations))
@@ -197,6 +168,49 @@ func mapTier(tier TeamEscalationsTierModel) *teamEscalationsTier {
 	}
 }
 
+func mapRoundRobinSettingsToRequest(settings *TeamEscalationsRoundRobinSettingsModel) *roundRobinSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &roundRobinSettings{
+		RoundRobinSize: settings.RoundRobinSize.ValueInt64Pointer(),
+	}
+}
+
+func mapScheduleSettingsToRequest(settings *TeamEscalationsScheduleSettingsModel) *scheduleSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &scheduleSettings{
+		Start:           settings.Start.ValueStringPointer(),
+		End:             settings.End.ValueStringPointer(),
+		SelectedDays:    ListToStringArray(settings.SelectedDays),
+		WeeklySchedules: mapTeamEscalationsWeeklySchedulesToRequest(settings.WeeklySchedules),
+		EffectiveFrom:   settings.EffectiveFrom.ValueStringPointer(),
+		EffectiveUntil:  settings.EffectiveUntil.ValueStringPointer(),
+	}
+}
+
+func mapRotationSettingsToRequest(settings *TeamEscalationsRotationSettingsModel) *rotationSettings {
+	if settings == nil {
+		return nil
+	}
+
+	return &rotationSettings{
+		Repeats:             settings.Repeats.ValueStringPointer(),
+		StartsOnDayOfWeek:   settings.StartsOnDayOfWeek.ValueStringPointer(),
+		StartsOnDateOfMonth: settings.StartsOnDateOfMonth.ValueInt64Pointer(),
+		StartsOnTime:        settings.StartsOnTime.ValueStringPointer(),
+		CustomRepeatUnit:    settings.CustomRepeatUnit.ValueStringPointer(),
+		CustomRepeatValue:   settings.CustomRepeatValue.ValueInt64Pointer(),
+		EffectiveFrom:       settings.EffectiveFrom.ValueStringPointer(),
+		RotationMode:        settings.RotationMode.ValueStringPointer(),
+		AutoRotationSize:    settings.AutoRotationSize.ValueInt64Pointer(),
+	}
+}
+
 func mapTeamEscalationsTimeFiltersToRequest(timeFilters *[]TeamEscalationsTimeFilterModel) *[]teamEscalationsTimeFilter {
 	if timeFilters == nil {
 		return nil
@@ -231,8 +245,9 @@ func mapTeamEscalationsWeeklySchedulesToRequest(weeklySchedules *[]TeamEscalatio
 	return &requestWeeklySchedules
 }
 
-func (c *AllQuietAPIClient) CreateTeamEscalationsResource(ctx context.Context, data *TeamEscalationsModel) (*teamEscalationsResponse, error) {
+func (c *AllQuietAPIClient) CreateTeamEscalationsResource(ctx context.Context, data *TeamEscalationsModel, expandedRefs map[string]*teamEscalationsSchedule) (*teamEscalationsResponse, error) {
 	reqBody := mapTeamEscalationsCreateRequest(data)
+	appendExpandedScheduleRefs(data, reqBody, expandedRefs)
 
 	url := "/team-escalations"
 	httpResp, err := c.post(ctx, url, reqBody)
@@ -269,8 +284,9 @@ func (c *AllQuietAPIClient) DeleteTeamEscalationsResource(ctx context.Context, i
 	return nil
 }
 
-func (c *AllQuietAPIClient) UpdateTeamEscalationsResource(ctx context.Context, id string, data *TeamEscalationsModel) (*teamEscalationsResponse, error) {
+func (c *AllQuietAPIClient) UpdateTeamEscalationsResource(ctx context.Context, id string, data *TeamEscalationsModel, expandedRefs map[string]*teamEscalationsSchedule) (*teamEscalationsResponse, error) {
 	reqBody := mapTeamEscalationsCreateRequest(data)
+	appendExpandedScheduleRefs(data, reqBody, expandedRefs)
 
 	url := fmt.Sprintf("/team-escalations/%s", url.PathEscape(id))
 	httpResp, err := c.put(ctx, url, reqBody)
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TeamEscalationsDataSource{}
+
+func NewTeamEscalationsDataSource() datasource.DataSource {
+	return &TeamEscalationsDataSource{}
+}
+
+// TeamEscalationsDataSource defines the data source implementation.
+// It shares its data model with the allquiet_team_escalations resource.
+type TeamEscalationsDataSource struct {
+	client *AllQuietAPIClient
+}
+
+func (d *TeamEscalationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_escalations"
+}
+
+func (d *TeamEscalationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	timeFilterAttributes := map[string]schema.Attribute{
+		"selected_days": schema.ListAttribute{
+			Computed:            true,
+			MarkdownDescription: "Days of the week",
+			ElementType:         types.StringType,
+		},
+		"from": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "From time of the time filter. Format: HH:mm",
+		},
+		"until": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Until time of the time filter. Format: HH:mm",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Team escalations data source. Reads the escalation tiers, schedules and rotations of a team.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Id of the team escalations",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the team to look up the escalations for",
+				Required:            true,
+			},
+			"tier_settings": schema.SingleNestedAttribute{
+				MarkdownDescription: "Settings applying to all tiers",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"repeats": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "How many times all tiers repeat.",
+					},
+					"repeats_after_minutes": schema.Int64Attribute{
+						Computed:            true,
+						MarkdownDescription: "How many minutes after the last tier all tiers repeat.",
+					},
+					"repeats_stop_mode": schema.StringAttribute{
+						Computed:            true,
+						MarkdownDescription: "When all tiers stop repeating.",
+					},
+				},
+			},
+			"escalation_tiers": schema.ListNestedAttribute{
+				MarkdownDescription: "Escalation tiers of the team",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"auto_escalation_enabled": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether auto-escalation is enabled for this tier.",
+						},
+						"auto_escalation_after_minutes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "After how many minutes the incident is auto-escalated to the next tier or auto-assigned to teams.",
+						},
+						"auto_escalation_stop_mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the escalation is stopped.",
+						},
+						"auto_escalation_severities": schema.ListAttribute{
+							Computed:            true,
+							MarkdownDescription: "Severities that trigger auto-escalation.",
+							ElementType:         types.StringType,
+						},
+						"auto_escalation_time_filters": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time filters in which auto-escalation is triggered.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: timeFilterAttributes,
+							},
+						},
+						"auto_assign_to_teams": schema.ListAttribute{
+							Computed:            true,
+							MarkdownDescription: "Team IDs that are auto-assigned to.",
+							ElementType:         types.StringType,
+						},
+						"auto_assign_to_teams_repeat_alerts": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: "Whether all on-call users are notified when auto-assigning to teams.",
+						},
+						"auto_assign_to_teams_severities": schema.ListAttribute{
+							Computed:            true,
+							MarkdownDescription: "Severities that trigger auto-assign to teams.",
+							ElementType:         types.StringType,
+						},
+						"auto_assign_to_teams_time_filters": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Time filters in which auto-assign to teams is triggered.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: timeFilterAttributes,
+							},
+						},
+						"repeats": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "How many times the tier repeats.",
+						},
+						"repeats_after_minutes": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: "How many minutes after the tier repeats.",
+						},
+						"repeats_stop_mode": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When this tier stops being repeated.",
+						},
+						"schedule_refs": schema.ListAttribute{
+							Computed:            true,
+							MarkdownDescription: "Always empty, shared schedules are returned as part of `schedules`.",
+							ElementType:         types.StringType,
+						},
+						"schedules": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "Schedules of the tier",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"display_name": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "Display name of the schedule.",
+									},
+									"rotations": schema.ListNestedAttribute{
+										Computed:            true,
+										MarkdownDescription: "Rotations of the schedule",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"members": schema.ListNestedAttribute{
+													Computed:            true,
+													MarkdownDescription: "Members of the rotation",
+													NestedObject: schema.NestedAttributeObject{
+														Attributes: map[string]schema.Attribute{
+															"team_membership_id": schema.StringAttribute{
+																Computed:            true,
+																MarkdownDescription: "Id of the team membership",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+									"schedule_settings": schema.SingleNestedAttribute{
+										Computed:            true,
+										MarkdownDescription: "Settings for the schedule",
+										Attributes: map[string]schema.Attribute{
+											"start": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "Start time of the schedule. Format: HH:mm",
+											},
+											"end": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "End time of the schedule. Format: HH:mm",
+											},
+											"selected_days": schema.ListAttribute{
+												Computed:            true,
+												MarkdownDescription: "Selected days of the week",
+												ElementType:         types.StringType,
+											},
+											"weekly_schedules": schema.ListNestedAttribute{
+												Computed:            true,
+												MarkdownDescription: "Weekly schedules",
+												NestedObject: schema.NestedAttributeObject{
+													Attributes: timeFilterAttributes,
+												},
+											},
+											"effective_from": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "Date in ISO 8601 format the schedule is effective from",
+											},
+											"effective_until": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "Date in ISO 8601 format the schedule is effective until",
+											},
+										},
+									},
+									"round_robin_settings": schema.SingleNestedAttribute{
+										Computed:            true,
+										MarkdownDescription: "Settings for round robin alerting",
+										Attributes: map[string]schema.Attribute{
+											"round_robin_size": schema.Int64Attribute{
+												Computed:            true,
+												MarkdownDescription: "Number of users assigned per incident",
+											},
+										},
+									},
+									"rotation_settings": schema.SingleNestedAttribute{
+										Computed:            true,
+										MarkdownDescription: "Settings for the rotation",
+										Attributes: map[string]schema.Attribute{
+											"repeats": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "The interval the rotation repeats on",
+											},
+											"starts_on_day_of_week": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "Day of the week the rotation starts on",
+											},
+											"starts_on_date_of_month": schema.Int64Attribute{
+												Computed:            true,
+												MarkdownDescription: "Zero-based date of the month the rotation starts on",
+											},
+											"starts_on_time": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "Time of day the rotation starts on. Format: HH:mm",
+											},
+											"custom_repeat_unit": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "Interval unit of a custom rotation",
+											},
+											"custom_repeat_value": schema.Int64Attribute{
+												Computed:            true,
+												MarkdownDescription: "How often a custom rotation repeats",
+											},
+											"effective_from": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "Date in ISO 8601 format the rotation is effective from",
+											},
+											"rotation_mode": schema.StringAttribute{
+												Computed:            true,
+												MarkdownDescription: "The mode of the rotation",
+											},
+											"auto_rotation_size": schema.Int64Attribute{
+												Computed:            true,
+												MarkdownDescription: "The size of the rotation",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TeamEscalationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TeamEscalationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TeamEscalationsModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamEscalationsResponse, err := d.client.GetTeamEscalationsDataSource(ctx, data.TeamId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get team escalations, got error: %s", err))
+		return
+	}
+
+	if teamEscalationsResponse == nil {
+		resp.Diagnostics.AddError("Client Error", "Did not find team escalations for the provided team_id")
+		return
+	}
+
+	mapTeamEscalationsResponseToModel(ctx, teamEscalationsResponse, &data, nil)
+	data.TierSettings = mapTierSettingsResponseToData(teamEscalationsResponse.TierSettings)
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func mapTierSettingsResponseToData(tierSettings *tierSettings) *TierSettingsModel {
+	if tierSettings == nil {
+		return nil
+	}
+	return &TierSettingsModel{
+		Repeats:             types.Int64PointerValue(tierSettings.Repeats),
+		RepeatsAfterMinutes: types.Int64PointerValue(tierSettings.RepeatsAfterMinutes),
+		RepeatsStopMode:     types.StringPointerValue(tierSettings.RepeatsStopMode),
+	}
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+func (c *AllQuietAPIClient) GetTeamEscalationsDataSource(ctx context.Context, teamId string) (*teamEscalationsResponse, error) {
+	url := AddQueryParam("/team-escalations/search", "teamId", teamId)
+
+	httpResp, err := c.get(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, logErrorResponse(httpResp, nil)
+	}
+
+	var result teamEscalationsResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
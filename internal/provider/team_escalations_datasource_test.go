@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamEscalationsDataSource(t *testing.T) {
+	uid := uuid.New().String()
+	email := fmt.Sprintf("acceptance-tests+galois+%s@allquiet.app", uid)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamEscalationsDataSourceConfig(email),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.allquiet_team_escalations.test", "id", "allquiet_team_escalations.test", "id"),
+					resource.TestCheckResourceAttr("data.allquiet_team_escalations.test", "escalation_tiers.#", "1"),
+					resource.TestCheckResourceAttr("data.allquiet_team_escalations.test", "escalation_tiers.0.auto_escalation_severities.#", "2"),
+					resource.TestCheckResourceAttr("data.allquiet_team_escalations.test", "escalation_tiers.0.schedules.0.schedule_settings.weekly_schedules.0.from", "00:00"),
+					resource.TestCheckResourceAttrPair("data.allquiet_team_escalations.test", "escalation_tiers.0.schedules.0.rotations.0.members.0.team_membership_id", "allquiet_team_membership.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamEscalationsDataSourceConfig(email string) string {
+	return fmt.Sprintf(`
+		resource "allquiet_user" "test" {
+			display_name = "Galois"
+			email        = %[1]q
+		}
+
+		resource "allquiet_team" "test" {
+			display_name = "TF Escalations Data Source Team"
+			time_zone_id = "Europe/Zurich"
+		}
+
+		resource "allquiet_team_membership" "test" {
+			team_id = allquiet_team.test.id
+			user_id = allquiet_user.test.id
+			role    = "Member"
+		}
+
+		resource "allquiet_team_escalations" "test" {
+			team_id = allquiet_team.test.id
+			escalation_tiers = [
+				{
+					auto_escalation_enabled       = true
+					auto_escalation_after_minutes = 10
+					auto_escalation_severities    = ["Critical", "Warning"]
+					schedules = [
+						{
+							schedule_settings = {
+								weekly_schedules = [
+									{
+										selected_days = ["mon", "tue", "wed", "thu", "fri", "sat", "sun"]
+										from          = "00:00"
+										until         = "00:00"
+									}
+								]
+							}
+							rotations = [
+								{
+									members = [
+										{ team_membership_id = allquiet_team_membership.test.id }
+									]
+								}
+							]
+						}
+					]
+				}
+			]
+		}
+
+		data "allquiet_team_escalations" "test" {
+			team_id    = allquiet_team.test.id
+			depends_on = [allquiet_team_escalations.test]
+		}
+	`, email)
+}
+
+func TestAccTeamEscalationsDataSourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamEscalationsDataSourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.allquiet_team_escalations.platform", "escalation_tiers.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamEscalationsDataSourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/data-sources/allquiet_team_escalations/data-source.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(dat)
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
@@ -50,6 +51,7 @@ type TeamEscalationsTierModel struct {
 	AutoAssignToTeamsSeverities   types.List                        `tfsdk:"auto_assign_to_teams_severities"`
 	AutoAssignToTeamsTimeFilters  *[]TeamEscalationsTimeFilterModel `tfsdk:"auto_assign_to_teams_time_filters"`
 	Schedules                     []TeamEscalationsScheduleModel    `tfsdk:"schedules"`
+	ScheduleRefs                  types.List                        `tfsdk:"schedule_refs"`
 }
 
 type TeamEscalationsTimeFilterModel struct {
@@ -282,8 +284,17 @@ func (r *TeamEscalations) Schema(ctx context.Context, req resource.SchemaRequest
 							MarkdownDescription: "When this tier should stop being repeated. Possible values are: " + strings.Join(ValidEscalationModes, ", "),
 							Validators:          []validator.String{stringvalidator.OneOf(ValidEscalationModes...)},
 						},
+						"schedule_refs": schema.ListAttribute{
+							Optional:            true,
+							MarkdownDescription: "References to shared schedules, taken from the `ref` attribute of `allquiet_schedule` data sources. They are expanded into schedules of this tier and added after `schedules`. The members of their rotations need to be members of this team. If an expanded schedule is changed in All Quiet, its ref is planned to be re-applied.",
+							ElementType:         types.StringType,
+						},
 						"schedules": schema.ListNestedAttribute{
-							Required: true,
+							Optional:            true,
+							MarkdownDescription: "Schedules of the tier. Either `schedules` or `schedule_refs` need to be set.",
+							Validators: []validator.List{
+								listvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("schedule_refs")),
+							},
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
 									"display_name": schema.StringAttribute{
@@ -485,12 +496,18 @@ func (r *TeamEscalations) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	teamEscalationsResponse, err := r.client.CreateTeamEscalationsResource(ctx, &data)
+	expandedRefs, err := r.client.expandScheduleRefs(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create teamEscalations resource, got error: %s", err))
 		return
 	}
-	mapTeamEscalationsResponseToModel(ctx, teamEscalationsResponse, &data)
+
+	teamEscalationsResponse, err := r.client.CreateTeamEscalationsResource(ctx, &data, expandedRefs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create teamEscalations resource, got error: %s", err))
+		return
+	}
+	mapTeamEscalationsResponseToModel(ctx, teamEscalationsResponse, &data, expandedRefs)
 
 	tflog.Trace(ctx, "created teamEscalations resource")
 
@@ -518,7 +535,13 @@ func (r *TeamEscalations) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	mapTeamEscalationsResponseToModel(ctx, teamEscalationsResponse, &data)
+	expandedRefs, err := r.client.expandScheduleRefsForRead(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get teamEscalations resource, got error: %s", err))
+		return
+	}
+
+	mapTeamEscalationsResponseToModel(ctx, teamEscalationsResponse, &data, expandedRefs)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -533,13 +556,19 @@ func (r *TeamEscalations) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	teamEscalationsResponse, err := r.client.UpdateTeamEscalationsResource(ctx, data.Id.ValueString(), &data)
+	expandedRefs, err := r.client.expandScheduleRefs(ctx, &data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update teamEscalations resource, got error: %s", err))
 		return
 	}
 
-	mapTeamEscalationsResponseToModel(ctx, teamEscalationsResponse, &data)
+	teamEscalationsResponse, err := r.client.UpdateTeamEscalationsResource(ctx, data.Id.ValueString(), &data, expandedRefs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update teamEscalations resource, got error: %s", err))
+		return
+	}
+
+	mapTeamEscalationsResponseToModel(ctx, teamEscalationsResponse, &data, expandedRefs)
 
 	tflog.Trace(ctx, "updated teamEscalations resource")
 
@@ -571,15 +600,32 @@ func (r *TeamEscalations) ImportState(ctx context.Context, req resource.ImportSt
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
-func mapTeamEscalationsResponseToModel(ctx context.Context, response *teamEscalationsResponse, data *TeamEscalationsModel) {
+// mapTeamEscalationsResponseToModel maps the response. expandedRefs are the schedules the schedule_refs of
+// data expand to, see expandScheduleRefs.
+func mapTeamEscalationsResponseToModel(ctx context.Context, response *teamEscalationsResponse, data *TeamEscalationsModel, expandedRefs map[string]*teamEscalationsSchedule) {
 	data.Id = types.StringValue(response.Id)
 	data.TeamId = types.StringValue(response.TeamId)
-	data.EscalationTiers = mapTeamEscalationsTiersResponseToData(ctx, response.EscalationTiers)
+	data.EscalationTiers = mapTeamEscalationsTiersResponseToData(ctx, response.EscalationTiers, data.EscalationTiers, expandedRefs)
 }
 
-func mapTeamEscalationsTiersResponseToData(ctx context.Context, data []teamEscalationsTier) []TeamEscalationsTierModel {
+// mapTeamEscalationsTiersResponseToData maps the tiers of the response. Schedules expanded from the schedule_refs
+// of the prior tiers are recognized by their content and removed from the inline schedules. Refs whose schedule
+// is no longer found in the tier, e.g. because it was changed in All Quiet, are removed so they show up as drift.
+func mapTeamEscalationsTiersResponseToData(ctx context.Context, data []teamEscalationsTier, priorTiers []TeamEscalationsTierModel, expandedRefs map[string]*teamEscalationsSchedule) []TeamEscalationsTierModel {
 	tiers := make([]TeamEscalationsTierModel, 0, len(data))
-	for _, tier := range data {
+	for i, tier := range data {
+		scheduleRefs := types.ListNull(types.StringType)
+		responseSchedules := tier.Schedules
+		if i < len(priorTiers) && !priorTiers[i].ScheduleRefs.IsNull() {
+			var matchedRefs []string
+			responseSchedules, matchedRefs = removeExpandedScheduleRefs(responseSchedules, ListToStringArray(priorTiers[i].ScheduleRefs), expandedRefs)
+			scheduleRefs, _ = types.ListValueFrom(ctx, types.StringType, matchedRefs)
+		}
+
+		schedules := mapTeamEscalationsSchedulesResponseToData(ctx, responseSchedules)
+		if len(schedules) == 0 && i < len(priorTiers) && priorTiers[i].Schedules == nil {
+			schedules = nil
+		}
 
 		var autoEscalationAfterMinutes types.Int64
 		if tier.AutoEscalationAfterMinutes != nil {
@@ -601,12 +647,41 @@ func mapTeamEscalationsTiersResponseToData(ctx context.Context, data []teamEscal
 			Repeats:                       types.Int64PointerValue(tier.Repeats),
 			RepeatsAfterMinutes:           types.Int64PointerValue(tier.RepeatsAfterMinutes),
 			RepeatsStopMode:               types.StringPointerValue(tier.RepeatsStopMode),
-			Schedules:                     mapTeamEscalationsSchedulesResponseToData(ctx, tier.Schedules),
+			Schedules:                     schedules,
+			ScheduleRefs:                  scheduleRefs,
 		})
 	}
 	return tiers
 }
 
+// removeExpandedScheduleRefs removes the schedules expanded from refs and returns the remaining schedules
+// together with the refs that were found. Expanded schedules are appended to the tier, so they are searched
+// from the end.
+func removeExpandedScheduleRefs(schedules []teamEscalationsSchedule, refs *[]string, expandedRefs map[string]*teamEscalationsSchedule) ([]teamEscalationsSchedule, []string) {
+	remaining := slices.Clone(schedules)
+	matchedRefs := []string{}
+	if refs == nil {
+		return remaining, matchedRefs
+	}
+
+	for _, ref := range *refs {
+		expanded, ok := expandedRefs[ref]
+		if !ok {
+			continue
+		}
+
+		for j := len(remaining) - 1; j >= 0; j-- {
+			if scheduleMatchesExpandedRef(remaining[j], expanded) {
+				remaining = slices.Delete(remaining, j, j+1)
+				matchedRefs = append(matchedRefs, ref)
+				break
+			}
+		}
+	}
+
+	return remaining, matchedRefs
+}
+
 func mapTeamEscalationsTimeFiltersToData(ctx context.Context, timeFilters *[]teamEscalationsTimeFilter) *[]TeamEscalationsTimeFilterModel {
 	var timeFiltersData []TeamEscalationsTimeFilterModel
 	if timeFilters == nil {
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// GetTeamMembersResource returns all memberships of the team. Returns nil if the team does not exist.
+func (c *AllQuietAPIClient) GetTeamMembersResource(ctx context.Context, teamId string) ([]teamMembershipDataSourceResponse, error) {
+	result, err := c.GetTeamMembershipsDataSource(ctx, &TeamMembershipsDataSourceModel{TeamId: types.StringValue(teamId)}, nil)
+	if err != nil || result == nil {
+		return nil, err
+	}
+
+	memberships := make([]teamMembershipDataSourceResponse, 0, len(result.TeamMemberships))
+	for _, membership := range result.TeamMemberships {
+		// The list endpoint filters by team, but we do not want to act on other teams' memberships in any case
+		if membership.TeamId == teamId {
+			memberships = append(memberships, membership)
+		}
+	}
+
+	return memberships, nil
+}
+
+// UpdateTeamMembersResource converges the memberships of the team on the planned members. Members are
+// added and re-roled before others are removed, so the team is never left without its administrators.
+func (c *AllQuietAPIClient) UpdateTeamMembersResource(ctx context.Context, data *TeamMembersModel) ([]teamMembershipDataSourceResponse, error) {
+	teamId := data.TeamId.ValueString()
+
+	desired := make(map[string]string, len(data.Members))
+	for _, member := range data.Members {
+		userId := member.UserId.ValueString()
+		if _, ok := desired[userId]; ok {
+			return nil, fmt.Errorf("user %s is listed more than once in members", userId)
+		}
+		desired[userId] = member.Role.ValueString()
+	}
+
+	current, err := c.GetTeamMembersResource(ctx, teamId)
+	if err != nil {
+		return nil, err
+	}
+
+	if current == nil {
+		return nil, fmt.Errorf("team %s not found", teamId)
+	}
+
+	existing := make(map[string]teamMembershipDataSourceResponse, len(current))
+	for _, membership := range current {
+		existing[membership.UserId] = membership
+	}
+
+	for _, member := range data.Members {
+		userId := member.UserId.ValueString()
+		membership := &TeamMembershipModel{TeamId: data.TeamId, UserId: member.UserId, Role: member.Role}
+
+		existingMembership, ok := existing[userId]
+		if !ok {
+			tflog.Debug(ctx, fmt.Sprintf("adding user %s to team %s", userId, teamId))
+			if _, err := c.CreateTeamMembershipResource(ctx, membership); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if existingMembership.Role != member.Role.ValueString() {
+			tflog.Debug(ctx, fmt.Sprintf("changing role of user %s in team %s", userId, teamId))
+			if _, err := c.UpdateTeamMembershipResource(ctx, existingMembership.Id, membership); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, membership := range current {
+		if _, ok := desired[membership.UserId]; ok {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("removing user %s from team %s", membership.UserId, teamId))
+		if err := c.DeleteTeamMembershipResource(ctx, membership.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.GetTeamMembersResource(ctx, teamId)
+}
+
+// DeleteTeamMembersResource removes the members known to the state from the team.
+func (c *AllQuietAPIClient) DeleteTeamMembersResource(ctx context.Context, data *TeamMembersModel) error {
+	current, err := c.GetTeamMembersResource(ctx, data.TeamId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	managed := make(map[string]bool, len(data.Members))
+	for _, member := range data.Members {
+		managed[member.UserId.ValueString()] = true
+	}
+
+	for _, membership := range current {
+		if !managed[membership.UserId] {
+			continue
+		}
+
+		if err := c.DeleteTeamMembershipResource(ctx, membership.Id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
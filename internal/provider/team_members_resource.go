@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TeamMembers{}
+var _ resource.ResourceWithImportState = &TeamMembers{}
+
+func NewTeamMembers() resource.Resource {
+	return &TeamMembers{}
+}
+
+type TeamMembers struct {
+	client *AllQuietAPIClient
+}
+
+type TeamMembersModel struct {
+	Id      types.String             `tfsdk:"id"`
+	TeamId  types.String             `tfsdk:"team_id"`
+	Members []TeamMembersMemberModel `tfsdk:"members"`
+}
+
+type TeamMembersMemberModel struct {
+	UserId types.String `tfsdk:"user_id"`
+	Role   types.String `tfsdk:"role"`
+}
+
+func (r *TeamMembers) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_members"
+}
+
+func (r *TeamMembers) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "The team members resource authoritatively manages the full membership of a team. Members that are added outside of Terraform, e.g. through the UI, show up as drift and are removed on the next apply. Do not combine it with `allquiet_team_membership` resources for the same team.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Id, equal to the team id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The id of the team whose members are managed",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: "The complete set of members of the team. Each user can only be listed once.",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "The user id of the member",
+							Required:            true,
+						},
+						"role": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Role of the member. Possible values are: " + strings.Join(ValidTeamMembershipRoles, ", "),
+							Validators:          []validator.String{stringvalidator.OneOf(ValidTeamMembershipRoles...)},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamMembers) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TeamMembers) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TeamMembersModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := r.client.UpdateTeamMembersResource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create team members resource, got error: %s", err))
+		return
+	}
+
+	mapTeamMembersResponseToModel(data.TeamId.ValueString(), memberships, &data)
+
+	tflog.Trace(ctx, "created team members resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembers) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TeamMembersModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := r.client.GetTeamMembersResource(ctx, data.TeamId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get team members resource, got error: %s", err))
+		return
+	}
+
+	if memberships == nil {
+		resp.Diagnostics.AddError("Client Error", "Unable to get team members resource, got nil response")
+		return
+	}
+
+	mapTeamMembersResponseToModel(data.TeamId.ValueString(), memberships, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembers) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TeamMembersModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := r.client.UpdateTeamMembersResource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update team members resource, got error: %s", err))
+		return
+	}
+
+	mapTeamMembersResponseToModel(data.TeamId.ValueString(), memberships, &data)
+
+	tflog.Trace(ctx, "updated team members resource")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TeamMembers) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TeamMembersModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteTeamMembersResource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete team members resource, got error: %s", err))
+		return
+	}
+
+	tflog.Trace(ctx, "deleted team members resource")
+}
+
+func (r *TeamMembers) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), req.ID)...)
+}
+
+func mapTeamMembersResponseToModel(teamId string, memberships []teamMembershipDataSourceResponse, data *TeamMembersModel) {
+	data.Id = types.StringValue(teamId)
+	data.TeamId = types.StringValue(teamId)
+	data.Members = make([]TeamMembersMemberModel, len(memberships))
+	for i, membership := range memberships {
+		data.Members[i] = TeamMembersMemberModel{
+			UserId: types.StringValue(membership.UserId),
+			Role:   types.StringValue(membership.Role),
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamMembersResource(t *testing.T) {
+	millieEmail := fmt.Sprintf("acceptance-tests+millie+%s@allquiet.app", uuid.New().String())
+	taylorEmail := fmt.Sprintf("acceptance-tests+taylor+%s@allquiet.app", uuid.New().String())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMembersResourceConfig(millieEmail, taylorEmail, `
+    {
+      user_id = allquiet_user.millie_brown.id
+      role    = "Administrator"
+    },
+    {
+      user_id = allquiet_user.taylor_swift.id
+      role    = "Member"
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_team_members.test", "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("allquiet_team_members.test", "members.*", map[string]string{"role": "Administrator"}),
+					resource.TestCheckTypeSetElemNestedAttrs("allquiet_team_members.test", "members.*", map[string]string{"role": "Member"}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_team_members.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing: re-role one member and remove the other
+			{
+				Config: testAccTeamMembersResourceConfig(millieEmail, taylorEmail, `
+    {
+      user_id = allquiet_user.taylor_swift.id
+      role    = "Administrator"
+    }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_team_members.test", "members.#", "1"),
+					resource.TestCheckResourceAttr("allquiet_team_members.test", "members.0.role", "Administrator"),
+					resource.TestCheckResourceAttrPair("allquiet_team_members.test", "members.0.user_id", "allquiet_user.taylor_swift", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTeamMembersResourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccTeamMembersResourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("allquiet_team_members.my_team", "members.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "allquiet_team_members.my_team",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccTeamMembersResourceConfig(millieEmail string, taylorEmail string, members string) string {
+	return fmt.Sprintf(`
+resource "allquiet_team" "team" {
+  display_name = "Root"
+}
+
+resource "allquiet_user" "millie_brown" {
+  display_name = "Millie Bobby Brown"
+  email        = %[1]q
+}
+
+resource "allquiet_user" "taylor_swift" {
+  display_name = "Taylor Swift"
+  email        = %[2]q
+}
+
+resource "allquiet_team_members" "test" {
+  team_id = allquiet_team.team.id
+  members = [%[3]s
+  ]
+}
+`, millieEmail, taylorEmail, members)
+}
+
+func testAccTeamMembersResourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/resources/allquiet_team_members/resource.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return RandomizeExample(string(dat))
+}
@@ -3,12 +3,13 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"net/http"
+	"io"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 type teamMembershipsDataSourceResponse struct {
+	pagedResponse
 	TeamMemberships []teamMembershipDataSourceResponse `json:"teamMemberships"`
 }
 
@@ -19,26 +20,24 @@ func (c *AllQuietAPIClient) GetTeamMembershipsDataSource(ctx context.Context, te
 		return nil, nil
 	}
 
-	httpResp, err := c.get(ctx, *url)
+	var result teamMembershipsDataSourceResponse
+	found, err := c.getAllPages(ctx, *url, func(body io.Reader) (*string, error) {
+		var page teamMembershipsDataSourceResponse
+		if err := json.NewDecoder(body).Decode(&page); err != nil {
+			return nil, err
+		}
+
+		result.TeamMemberships = append(result.TeamMemberships, page.TeamMemberships...)
+		return page.ContinuationToken, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode == http.StatusNotFound {
+	if !found {
 		return nil, nil
 	}
 
-	if httpResp.StatusCode != http.StatusOK {
-		return nil, logErrorResponse(httpResp, nil)
-	}
-
-	var result teamMembershipsDataSourceResponse
-	err = json.NewDecoder(httpResp.Body).Decode(&result)
-	if err != nil {
-		return nil, err
-	}
-
 	return &result, nil
 }
 
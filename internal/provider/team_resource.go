@@ -69,10 +69,11 @@ func (r *Team) Schema(ctx context.Context, req resource.SchemaRequest, resp *res
 				Required:            true,
 			},
 			"time_zone_id": schema.StringAttribute{
-				MarkdownDescription: "The timezone id, defaults to 'UTC' if not provided. Find all timezone ids [here](https://allquiet.app/api/public/v1/timezone)",
+				MarkdownDescription: "The timezone id, defaults to 'UTC' if not provided. Find all timezone ids [here](https://allquiet.app/api/public/v1/timezone) or use the `allquiet_time_zones` data source",
 				Optional:            true,
 				Default:             stringdefault.StaticString("UTC"),
 				Computed:            true,
+				Validators:          []validator.String{TimeZoneValidator("Not a valid time zone id")},
 			},
 			"incident_engagement_report_settings": schema.SingleNestedAttribute{
 				MarkdownDescription: "Settings when to send the incident report for the team",
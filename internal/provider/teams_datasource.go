@@ -6,9 +6,13 @@ package provider
 import (
 	"context"
 	"fmt"
+	"path"
+	"regexp"
+	"slices"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -27,8 +31,11 @@ type TeamsDataSource struct {
 
 // TeamsDataSourceModel describes the data source data model.
 type TeamsDataSourceModel struct {
-	DisplayName types.String          `tfsdk:"display_name"`
-	Teams       []TeamDataSourceModel `tfsdk:"teams"`
+	DisplayName      types.String          `tfsdk:"display_name"`
+	DisplayNameRegex types.String          `tfsdk:"display_name_regex"`
+	DisplayNameGlob  types.String          `tfsdk:"display_name_glob"`
+	Labels           types.List            `tfsdk:"labels"`
+	Teams            []TeamDataSourceModel `tfsdk:"teams"`
 }
 
 func (d *TeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -44,6 +51,21 @@ func (d *TeamsDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				MarkdownDescription: "Display name of the team to look up",
 				Optional:            true,
 			},
+			"display_name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression (RE2 syntax) the display name of the team has to match. Evaluated by the provider after the teams have been fetched",
+				Optional:            true,
+				Validators:          []validator.String{RegexValidator("Invalid regular expression")},
+			},
+			"display_name_glob": schema.StringAttribute{
+				MarkdownDescription: "Glob pattern the display name of the team has to match, e.g. `Platform *`. Supports `*`, `?` and `[...]` as in Go's `path.Match`, where `*` does not match `/`. Evaluated by the provider after the teams have been fetched",
+				Optional:            true,
+				Validators:          []validator.String{GlobValidator("Invalid glob pattern")},
+			},
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "Only return teams that have all of these labels",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
 			"teams": schema.ListNestedAttribute{
 				MarkdownDescription: "List of teams",
 				Computed:            true,
@@ -115,6 +137,12 @@ func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	err = filterTeams(teamsResponse, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to filter teams, got error: %s", err))
+		return
+	}
+
 	mapTeamsResponseToDataSourceModel(ctx, teamsResponse, &data)
 
 	tflog.Trace(ctx, "read a data source")
@@ -122,6 +150,52 @@ func (d *TeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// filterTeams applies the filters the team search endpoint does not support.
+func filterTeams(teamsResponse *teamsDataSourceResponse, data *TeamsDataSourceModel) error {
+	var displayNameRegex *regexp.Regexp
+	if data.DisplayNameRegex.ValueString() != "" {
+		var err error
+		displayNameRegex, err = regexp.Compile(data.DisplayNameRegex.ValueString())
+		if err != nil {
+			return err
+		}
+	}
+
+	labels := NonNullableArrayToStringArray(ListToStringArray(data.Labels))
+
+	teams := make([]teamDataSourceResponse, 0, len(teamsResponse.Teams))
+	for _, team := range teamsResponse.Teams {
+		if displayNameRegex != nil && !displayNameRegex.MatchString(team.DisplayName) {
+			continue
+		}
+		if data.DisplayNameGlob.ValueString() != "" {
+			matched, err := path.Match(data.DisplayNameGlob.ValueString(), team.DisplayName)
+			if err != nil {
+				return err
+			}
+			if !matched {
+				continue
+			}
+		}
+		if !hasAllLabels(NonNullableArrayToStringArray(team.Labels), labels) {
+			continue
+		}
+		teams = append(teams, team)
+	}
+
+	teamsResponse.Teams = teams
+	return nil
+}
+
+func hasAllLabels(labels []string, required []string) bool {
+	for _, requiredLabel := range required {
+		if !slices.Contains(labels, requiredLabel) {
+			return false
+		}
+	}
+	return true
+}
+
 func mapTeamsResponseToDataSourceModel(ctx context.Context, teamsResponse *teamsDataSourceResponse, data *TeamsDataSourceModel) {
 	if teamsResponse.Teams == nil {
 		data.Teams = make([]TeamDataSourceModel, 0, len(teamsResponse.Teams))
@@ -3,12 +3,13 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"net/http"
+	"io"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 type teamsDataSourceResponse struct {
+	pagedResponse
 	Teams []teamDataSourceResponse `json:"teams"`
 }
 
@@ -19,26 +20,24 @@ func (c *AllQuietAPIClient) GetTeamsDataSource(ctx context.Context, teamsDataSou
 		return nil, nil
 	}
 
-	httpResp, err := c.get(ctx, *url)
+	var result teamsDataSourceResponse
+	found, err := c.getAllPages(ctx, *url, func(body io.Reader) (*string, error) {
+		var page teamsDataSourceResponse
+		if err := json.NewDecoder(body).Decode(&page); err != nil {
+			return nil, err
+		}
+
+		result.Teams = append(result.Teams, page.Teams...)
+		return page.ContinuationToken, nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	defer httpResp.Body.Close()
 
-	if httpResp.StatusCode == http.StatusNotFound {
+	if !found {
 		return nil, nil
 	}
 
-	if httpResp.StatusCode != http.StatusOK {
-		return nil, logErrorResponse(httpResp, nil)
-	}
-
-	var result teamsDataSourceResponse
-	err = json.NewDecoder(httpResp.Body).Decode(&result)
-	if err != nil {
-		return nil, err
-	}
-
 	return &result, nil
 }
 
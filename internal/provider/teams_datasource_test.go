@@ -28,6 +28,9 @@ func TestAccTeamsDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.allquiet_teams.test_with_labels", "teams.0.labels.#", "2"),
 					resource.TestCheckResourceAttr("data.allquiet_teams.test_with_labels", "teams.0.labels.0", "label1"),
 					resource.TestCheckResourceAttr("data.allquiet_teams.test_with_labels", "teams.0.labels.1", "label2"),
+					resource.TestCheckResourceAttr("data.allquiet_teams.test_by_labels", "teams.#", "1"),
+					resource.TestCheckResourceAttr("data.allquiet_teams.test_by_display_name_regex", "teams.#", "2"),
+					resource.TestCheckResourceAttr("data.allquiet_teams.test_by_display_name_glob", "teams.#", "2"),
 				),
 			},
 		},
@@ -63,6 +66,24 @@ func testAccTeamsDataSourceConfig(displayName string) string {
 			display_name = "Team with labels"
 			depends_on = [allquiet_team.team_with_labels]
 		}
+
+		data "allquiet_teams" "test_by_labels" {
+			display_name = "Team with labels"
+			labels       = ["label2"]
+			depends_on = [allquiet_team.team_with_labels]
+		}
+
+		data "allquiet_teams" "test_by_display_name_regex" {
+			display_name       = "%[1]s"
+			display_name_regex = " [23]$"
+			depends_on = [allquiet_team.test1, allquiet_team.test2, allquiet_team.test3]
+		}
+
+		data "allquiet_teams" "test_by_display_name_glob" {
+			display_name      = "%[1]s"
+			display_name_glob = "* [12]"
+			depends_on = [allquiet_team.test1, allquiet_team.test2, allquiet_team.test3]
+		}
 	`, displayName)
 }
 
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &TimeZonesDataSource{}
+
+func NewTimeZonesDataSource() datasource.DataSource {
+	return &TimeZonesDataSource{}
+}
+
+// TimeZonesDataSource defines the data source implementation.
+type TimeZonesDataSource struct {
+	client *AllQuietAPIClient
+}
+
+// TimeZonesDataSourceModel describes the data source data model.
+type TimeZonesDataSourceModel struct {
+	TimeZones []TimeZoneDataSourceModel `tfsdk:"time_zones"`
+}
+
+type TimeZoneDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	DisplayName types.String `tfsdk:"display_name"`
+}
+
+func (d *TimeZonesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_time_zones"
+}
+
+func (d *TimeZonesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Time zones data source. Lists all time zone ids accepted by All Quiet, e.g. for `time_zone_id` of users, teams and status pages.",
+		Attributes: map[string]schema.Attribute{
+			"time_zones": schema.ListNestedAttribute{
+				MarkdownDescription: "List of time zones",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The time zone id, e.g. `Europe/Zurich`",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "Display name of the time zone",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TimeZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*AllQuietAPIClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TimeZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TimeZonesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeZonesResponse, err := d.client.GetTimeZonesDataSource(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get time zones, got error: %s", err))
+		return
+	}
+
+	mapTimeZonesResponseToDataSourceModel(timeZonesResponse, &data)
+
+	tflog.Trace(ctx, "read a data source")
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func mapTimeZonesResponseToDataSourceModel(timeZonesResponse []timeZoneDataSourceResponse, data *TimeZonesDataSourceModel) {
+	data.TimeZones = make([]TimeZoneDataSourceModel, 0, len(timeZonesResponse))
+	for _, timeZone := range timeZonesResponse {
+		data.TimeZones = append(data.TimeZones, TimeZoneDataSourceModel{
+			Id:          types.StringValue(timeZone.Id),
+			DisplayName: types.StringValue(timeZone.DisplayName),
+		})
+	}
+}
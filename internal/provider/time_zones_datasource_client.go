@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+type timeZoneDataSourceResponse struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+}
+
+func (c *AllQuietAPIClient) GetTimeZonesDataSource(ctx context.Context) ([]timeZoneDataSourceResponse, error) {
+	httpResp, err := c.get(ctx, "/timezone")
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, logErrorResponse(httpResp, nil)
+	}
+
+	var result []timeZoneDataSourceResponse
+	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTimeZonesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "allquiet_time_zones" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.allquiet_time_zones.test", "time_zones.#"),
+					resource.TestCheckResourceAttrSet("data.allquiet_time_zones.test", "time_zones.0.id"),
+				),
+			},
+			{
+				Config: `
+					resource "allquiet_team" "test" {
+						display_name = "TF Time Zone Validation Team"
+						time_zone_id = "Europe/Zurch"
+					}
+				`,
+				ExpectError: regexp.MustCompile(`Did you mean "Europe/Zurich"`),
+			},
+		},
+	})
+}
+
+func TestAccTimeZonesDataSourceExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTimeZonesDataSourceExample(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.allquiet_time_zones.all", "time_zones.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTimeZonesDataSourceExample() string {
+	absPath, _ := filepath.Abs("../../examples/data-sources/allquiet_time_zones/data-source.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(dat)
+}
@@ -108,10 +108,11 @@ func (r *User) Schema(ctx context.Context, req resource.SchemaRequest, resp *res
 				Sensitive: true,
 			},
 			"time_zone_id": schema.StringAttribute{
-				MarkdownDescription: "The timezone id, defaults to 'UTC' if not provided. Find all timezone ids [here](https://allquiet.app/api/public/v1/timezone)",
+				MarkdownDescription: "The timezone id, defaults to 'UTC' if not provided. Find all timezone ids [here](https://allquiet.app/api/public/v1/timezone) or use the `allquiet_time_zones` data source",
 				Optional:            true,
 				Default:             stringdefault.StaticString("UTC"),
 				Computed:            true,
+				Validators:          []validator.String{TimeZoneValidator("Not a valid time zone id")},
 			},
 			"incident_notification_settings": schema.SingleNestedAttribute{
 				MarkdownDescription: "Settings which channels to use for incident notifications",
@@ -6,9 +6,14 @@ package provider
 import (
 	"context"
 	"fmt"
+	"path"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -27,9 +32,14 @@ type UsersDataSource struct {
 
 // UsersDataSourceModel describes the data source data model.
 type UsersDataSourceModel struct {
-	Email       types.String          `tfsdk:"email"`
-	DisplayName types.String          `tfsdk:"display_name"`
-	Users       []UserDataSourceModel `tfsdk:"users"`
+	Email            types.String          `tfsdk:"email"`
+	DisplayName      types.String          `tfsdk:"display_name"`
+	DisplayNameRegex types.String          `tfsdk:"display_name_regex"`
+	DisplayNameGlob  types.String          `tfsdk:"display_name_glob"`
+	EmailDomain      types.String          `tfsdk:"email_domain"`
+	TeamId           types.String          `tfsdk:"team_id"`
+	OrganizationRole types.String          `tfsdk:"organization_role"`
+	Users            []UserDataSourceModel `tfsdk:"users"`
 }
 
 func (d *UsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -51,6 +61,29 @@ func (d *UsersDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"display_name_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression (RE2 syntax) the display name of the user has to match. Evaluated by the provider after the users have been fetched",
+				Optional:            true,
+				Validators:          []validator.String{RegexValidator("Invalid regular expression")},
+			},
+			"display_name_glob": schema.StringAttribute{
+				MarkdownDescription: "Glob pattern the display name of the user has to match, e.g. `Platform *`. Supports `*`, `?` and `[...]` as in Go's `path.Match`, where `*` does not match `/`. Evaluated by the provider after the users have been fetched",
+				Optional:            true,
+				Validators:          []validator.String{GlobValidator("Invalid glob pattern")},
+			},
+			"email_domain": schema.StringAttribute{
+				MarkdownDescription: "Only return users whose email address belongs to this domain, e.g. `example.com`",
+				Optional:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Only return users that are members of this team",
+				Optional:            true,
+			},
+			"organization_role": schema.StringAttribute{
+				MarkdownDescription: "Only return users with this role in the organization. Possible values are: " + strings.Join(ValidOrganizationMembershipRoles, ", "),
+				Optional:            true,
+				Validators:          []validator.String{stringvalidator.OneOf(ValidOrganizationMembershipRoles...)},
+			},
 			"users": schema.ListNestedAttribute{
 				MarkdownDescription: "List of users",
 				Computed:            true,
@@ -122,6 +155,12 @@ func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
+	err = d.filterUsers(ctx, usersResponse, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to filter users, got error: %s", err))
+		return
+	}
+
 	mapUsersResponseToDataSourceModel(usersResponse, &data)
 
 	tflog.Trace(ctx, "read a data source")
@@ -129,6 +168,83 @@ func (d *UsersDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// filterUsers applies the filters the user search endpoint does not support.
+func (d *UsersDataSource) filterUsers(ctx context.Context, usersResponse *usersDataSourceResponse, data *UsersDataSourceModel) error {
+	var displayNameRegex *regexp.Regexp
+	if data.DisplayNameRegex.ValueString() != "" {
+		var err error
+		displayNameRegex, err = regexp.Compile(data.DisplayNameRegex.ValueString())
+		if err != nil {
+			return err
+		}
+	}
+
+	var teamUserIds map[string]bool
+	if data.TeamId.ValueString() != "" {
+		teamMemberships, err := d.client.GetTeamMembershipsDataSource(ctx, &TeamMembershipsDataSourceModel{
+			UserId: types.StringNull(),
+			TeamId: data.TeamId,
+			Role:   types.StringNull(),
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		teamUserIds = map[string]bool{}
+		if teamMemberships != nil {
+			for _, teamMembership := range teamMemberships.TeamMemberships {
+				teamUserIds[teamMembership.UserId] = true
+			}
+		}
+	}
+
+	var organizationRoleUserIds map[string]bool
+	if data.OrganizationRole.ValueString() != "" {
+		organizationMemberships, err := d.client.GetOrganizationMemberships(ctx, nil, data.OrganizationRole.ValueStringPointer())
+		if err != nil {
+			return err
+		}
+
+		organizationRoleUserIds = map[string]bool{}
+		for _, organizationMembership := range organizationMemberships {
+			if organizationMembership.Role == data.OrganizationRole.ValueString() {
+				organizationRoleUserIds[organizationMembership.UserId] = true
+			}
+		}
+	}
+
+	emailDomain := strings.ToLower(strings.TrimPrefix(data.EmailDomain.ValueString(), "@"))
+
+	users := make([]userDataSourceResponse, 0, len(usersResponse.Users))
+	for _, user := range usersResponse.Users {
+		if displayNameRegex != nil && !displayNameRegex.MatchString(user.DisplayName) {
+			continue
+		}
+		if data.DisplayNameGlob.ValueString() != "" {
+			matched, err := path.Match(data.DisplayNameGlob.ValueString(), user.DisplayName)
+			if err != nil {
+				return err
+			}
+			if !matched {
+				continue
+			}
+		}
+		if emailDomain != "" && !strings.HasSuffix(strings.ToLower(user.Email), "@"+emailDomain) {
+			continue
+		}
+		if teamUserIds != nil && !teamUserIds[user.Id] {
+			continue
+		}
+		if organizationRoleUserIds != nil && !organizationRoleUserIds[user.Id] {
+			continue
+		}
+		users = append(users, user)
+	}
+
+	usersResponse.Users = users
+	return nil
+}
+
 func mapUsersResponseToDataSourceModel(usersResponse *usersDataSourceResponse, data *UsersDataSourceModel) {
 	if usersResponse.Users == nil {
 		data.Users = make([]UserDataSourceModel, 0, len(usersResponse.Users))
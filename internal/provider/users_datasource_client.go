@@ -3,12 +3,13 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"net/http"
+	"io"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 type usersDataSourceResponse struct {
+	pagedResponse
 	Users []userDataSourceResponse `json:"users"`
 }
 
@@ -22,18 +23,16 @@ func (c *AllQuietAPIClient) GetUsersDataSource(ctx context.Context, data *UsersD
 		url = AddQueryParam(url, "displayName", data.DisplayName.ValueString())
 	}
 
-	httpResp, err := c.get(ctx, url)
-	if err != nil {
-		return nil, err
-	}
-	defer httpResp.Body.Close()
-
-	if httpResp.StatusCode != http.StatusOK {
-		return nil, logErrorResponse(httpResp, nil)
-	}
-
 	var result usersDataSourceResponse
-	err = json.NewDecoder(httpResp.Body).Decode(&result)
+	_, err := c.getAllPages(ctx, url, func(body io.Reader) (*string, error) {
+		var page usersDataSourceResponse
+		if err := json.NewDecoder(body).Decode(&page); err != nil {
+			return nil, err
+		}
+
+		result.Users = append(result.Users, page.Users...)
+		return page.ContinuationToken, nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -26,6 +26,11 @@ func TestAccUsersDataSource(t *testing.T) {
 				Config: testAccUsersDataSourceConfig(displayName, emailPrefix),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.allquiet_users.test_by_email", "users.#", "3"),
+					resource.TestCheckResourceAttr("data.allquiet_users.test_by_display_name_regex", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.allquiet_users.test_by_display_name_glob", "users.#", "2"),
+					resource.TestCheckResourceAttr("data.allquiet_users.test_by_email_domain", "users.#", "3"),
+					resource.TestCheckResourceAttr("data.allquiet_users.test_by_team", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.allquiet_users.test_by_team", "users.0.id", "allquiet_user.test1", "id"),
 				),
 			},
 		},
@@ -53,10 +58,44 @@ func testAccUsersDataSourceConfig(displayName, emailPrefix string) string {
 			phone_number = "+12035479053"
 		}
 
+		resource "allquiet_team" "test" {
+			display_name = "%[1]s Team"
+		}
+
+		resource "allquiet_team_membership" "test1" {
+			user_id = allquiet_user.test1.id
+			team_id = allquiet_team.test.id
+			role    = "Member"
+		}
+
 		data "allquiet_users" "test_by_email" {
 			email        = "%[2]s"
 			depends_on = [allquiet_user.test1, allquiet_user.test2, allquiet_user.test3]
 		}
+
+		data "allquiet_users" "test_by_display_name_regex" {
+			email              = "%[2]s"
+			display_name_regex = " [12]$"
+			depends_on = [allquiet_user.test1, allquiet_user.test2, allquiet_user.test3]
+		}
+
+		data "allquiet_users" "test_by_display_name_glob" {
+			email             = "%[2]s"
+			display_name_glob = "* [23]"
+			depends_on = [allquiet_user.test1, allquiet_user.test2, allquiet_user.test3]
+		}
+
+		data "allquiet_users" "test_by_email_domain" {
+			email        = "%[2]s"
+			email_domain = "allquiet.app"
+			depends_on = [allquiet_user.test1, allquiet_user.test2, allquiet_user.test3]
+		}
+
+		data "allquiet_users" "test_by_team" {
+			email   = "%[2]s"
+			team_id = allquiet_team.test.id
+			depends_on = [allquiet_team_membership.test1]
+		}
 	`, displayName, emailPrefix)
 }
 
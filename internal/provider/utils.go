@@ -18,8 +18,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 var ValidIntents = []string{
@@ -141,6 +144,65 @@ func MapIntSliceToNullableList(ctx context.Context, intArray *[]int) types.List
 	return listVal
 }
 
+// MapToStringMap converts a types.Map of strings to *map[string]string, skipping null and unknown elements.
+func MapToStringMap(m types.Map) *map[string]string {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+
+	result := make(map[string]string)
+	for k, v := range m.Elements() {
+		if v.IsNull() || v.IsUnknown() {
+			continue
+		}
+		str, ok := v.(types.String)
+		if !ok {
+			continue
+		}
+		result[k] = str.ValueString()
+	}
+
+	return &result
+}
+
+// MapNullableStringMap converts *map[string]string to a types.Map of strings, which is null when the map is nil.
+func MapNullableStringMap(stringMap *map[string]string) types.Map {
+	if stringMap == nil {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(*stringMap))
+	for k, v := range *stringMap {
+		elements[k] = types.StringValue(v)
+	}
+
+	mapValue, diags := types.MapValue(types.StringType, elements)
+	if diags.HasError() {
+		return types.MapNull(types.StringType)
+	}
+
+	return mapValue
+}
+
+// asKnownObject reads a known object into target. It reports false if the object is null or unknown, or holds
+// unknown nested objects or lists target cannot represent, e.g. from a for expression. Such values can only be
+// validated once they are known.
+func asKnownObject(ctx context.Context, object types.Object, target any) bool {
+	if object.IsNull() || object.IsUnknown() {
+		return false
+	}
+
+	return !object.As(ctx, target, basetypes.ObjectAsOptions{}).HasError()
+}
+
+// validateStringValue runs an attribute validator against a value that is validated outside of its attribute,
+// e.g. depending on another attribute.
+func validateStringValue(ctx context.Context, v validator.String, value types.String, valuePath path.Path) diag.Diagnostics {
+	response := validator.StringResponse{}
+	v.ValidateString(ctx, validator.StringRequest{Path: valuePath, ConfigValue: value}, &response)
+	return response.Diagnostics
+}
+
 type badRequestResponse struct {
 	Errors map[string][]string `json:"errors"`
 }
@@ -243,6 +305,26 @@ func DateTimeValidator(message string) validator.String {
 	return validators.DateTime(message)
 }
 
+func RegexValidator(message string) validator.String {
+	return validators.Regex(message)
+}
+
+func GlobValidator(message string) validator.String {
+	return validators.Glob(message)
+}
+
+func TimeZoneValidator(message string) validator.String {
+	return validators.TimeZone(message)
+}
+
+func CronExpressionValidator(message string) validator.String {
+	return validators.CronExpression(message)
+}
+
+func CIDRValidator(message string) validator.String {
+	return validators.CIDR(message)
+}
+
 func TimeValidator(message string) validator.String {
 	return stringvalidator.OneOf(ValidTimes...)
 }
@@ -301,6 +383,8 @@ var ValidOrganizationMembershipRoles = []string{"Member", "Owner", "Administrato
 
 var ValidEscalationModes = []string{"resolved", "acknowledged"}
 
+var ValidStatusPageIncidentUpdateStatuses = []string{"Investigating", "Identified", "Monitoring", "Resolved"}
+
 func OperatorValidator(message string) validator.String {
 	return stringvalidator.OneOf(ValidOperators...)
 }
@@ -319,12 +403,18 @@ func HexColorValidator(message string) validator.String {
 
 const OneMonthInSeconds = 2629746
 
-var ValidWebhookAuthenticationTypes = []string{"bearer"}
+var ValidWebhookAuthenticationTypes = []string{"bearer", "hmac"}
 
 func WebhookAuthenticationTypeValidator(message string) validator.String {
 	return stringvalidator.OneOf(ValidWebhookAuthenticationTypes...)
 }
 
+var ValidWebhookHmacAlgorithms = []string{"sha1", "sha256", "sha512"}
+
+func WebhookHmacAlgorithmValidator(message string) validator.String {
+	return stringvalidator.OneOf(ValidWebhookHmacAlgorithms...)
+}
+
 var ValidIntervalsInSeconds = []int64{30 * 1, 60 * 1, 60 * 2, 60 * 5, 60 * 10, 60 * 15, 60 * 30, 60 * 60, 60 * 1440}
 var ValidIntervalsInSecondsAsString = convertInt64ArrayToStringArray(ValidIntervalsInSeconds)
 
@@ -364,10 +454,86 @@ func ValidTimeoutsPingMonitorInMillisecondsValidator(message string) validator.I
 	return int64validator.OneOf(ValidTimeoutsPingMonitorInMilliseconds...)
 }
 
+var ValidTimeoutsTcpMonitorInMilliseconds = []int64{50, 100, 200, 500, 1000, 2000, 5000}
+
+func ValidTimeoutsTcpMonitorInMillisecondsValidator(message string) validator.Int64 {
+	return int64validator.OneOf(ValidTimeoutsTcpMonitorInMilliseconds...)
+}
+
+var ValidTimeoutsDnsMonitorInMilliseconds = []int64{100, 200, 500, 1000, 2000, 5000}
+
+func ValidTimeoutsDnsMonitorInMillisecondsValidator(message string) validator.Int64 {
+	return int64validator.OneOf(ValidTimeoutsDnsMonitorInMilliseconds...)
+}
+
+var ValidTimeoutsCertificateMonitorInMilliseconds = []int64{500, 1000, 2000, 5000, 10000, 30000}
+
+func ValidTimeoutsCertificateMonitorInMillisecondsValidator(message string) validator.Int64 {
+	return int64validator.OneOf(ValidTimeoutsCertificateMonitorInMilliseconds...)
+}
+
+var ValidDnsRecordTypes = []string{"A", "AAAA", "CAA", "CNAME", "MX", "NS", "PTR", "SOA", "SRV", "TXT"}
+
+func DnsRecordTypeValidator(message string) validator.String {
+	return stringvalidator.OneOf(ValidDnsRecordTypes...)
+}
+
+func PortValidator(message string) validator.Int64 {
+	return int64validator.Between(1, 65535)
+}
+
+var ValidHttpTransactionAssertionSources = []string{"StatusCode", "Body", "JsonPath", "Header", "ResponseTimeInMilliseconds"}
+
+func HttpTransactionAssertionSourceValidator(message string) validator.String {
+	return stringvalidator.OneOf(ValidHttpTransactionAssertionSources...)
+}
+
+var ValidHttpTransactionAssertionComparisons = []string{"Equals", "NotEquals", "Contains", "NotContains", "Matches", "LessThan", "GreaterThan"}
+
+func HttpTransactionAssertionComparisonValidator(message string) validator.String {
+	return stringvalidator.OneOf(ValidHttpTransactionAssertionComparisons...)
+}
+
+func HttpTransactionVariableValidator(message string) validator.String {
+	return stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`), message)
+}
+
 func ValidHttpStatusCodesValidator(message string) validator.Int64 {
 	return int64validator.Between(100, 599)
 }
 
+var ValidOutboundWebhookAuthenticationTypes = []string{"None", "Basic", "Bearer", "HmacSignature"}
+
+func OutboundWebhookAuthenticationTypeValidator(message string) validator.String {
+	return stringvalidator.OneOf(ValidOutboundWebhookAuthenticationTypes...)
+}
+
+var ValidOutboundWebhookMethods = []string{"POST", "PUT", "PATCH"}
+
+func OutboundWebhookMethodValidator(message string) validator.String {
+	return stringvalidator.OneOf(ValidOutboundWebhookMethods...)
+}
+
+func PayloadTemplateValidator(message string) validator.String {
+	return validators.PayloadTemplate(message)
+}
+
+func JSONPathValidator(message string) validator.String {
+	return validators.JSONPath(message)
+}
+
+func XPathValidator(message string) validator.String {
+	return validators.XPath(message)
+}
+
+func MappingRegexValidator(message string) validator.String {
+	return validators.MappingRegex(message)
+}
+
+func MapExpressionValidator(message string) validator.String {
+	return validators.MapExpression(message)
+}
+
 func AddQueryParam(currentUrl string, key string, value string) string {
 
 	if strings.Contains(currentUrl, "?") {
@@ -0,0 +1,53 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type cidrValidator struct {
+	message string
+}
+
+func (v cidrValidator) Description(_ context.Context) string {
+	return v.message
+}
+
+func (v cidrValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cidrValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	ip, network, err := net.ParseCIDR(value)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("%s: %s", v.message, err.Error()),
+		)
+		return
+	}
+
+	if !ip.Equal(network.IP) {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid CIDR",
+			fmt.Sprintf("%s: %s has host bits set, did you mean %s?", v.message, value, network.String()),
+		)
+	}
+}
+
+// CIDR returns a validator that ensures the value is an IPv4 or IPv6 network in CIDR notation without host bits set.
+func CIDR(message string) cidrValidator {
+	return cidrValidator{
+		message: message,
+	}
+}
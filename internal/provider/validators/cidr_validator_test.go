@@ -0,0 +1,53 @@
+package validators
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCIDR(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr string
+	}{
+		{name: "ipv4", value: types.StringValue("10.0.0.0/8")},
+		{name: "ipv4 host", value: types.StringValue("192.168.1.10/32")},
+		{name: "ipv6", value: types.StringValue("2001:db8::/32")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "address without prefix", value: types.StringValue("10.0.0.1"), wantErr: "invalid CIDR address"},
+		{name: "prefix out of range", value: types.StringValue("10.0.0.0/33"), wantErr: "invalid CIDR address"},
+		{name: "host bits set", value: types.StringValue("10.0.0.1/8"), wantErr: "did you mean 10.0.0.0/8?"},
+		{name: "ipv6 host bits set", value: types.StringValue("2001:db8::1/32"), wantErr: "did you mean 2001:db8::/32?"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := validator.StringResponse{}
+			CIDR("Not a valid CIDR").ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("cidr"),
+				ConfigValue: test.value,
+			}, &response)
+
+			if test.wantErr == "" {
+				if response.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", response.Diagnostics)
+				}
+				return
+			}
+
+			if !response.Diagnostics.HasError() {
+				t.Fatalf("expected error containing %q", test.wantErr)
+			}
+			if detail := response.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, test.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", detail, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,346 @@
+package validators
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedCronExpression is returned for expressions using constructs that All Quiet accepts but
+// the provider cannot evaluate, like L, W and # in the day fields.
+var ErrUnsupportedCronExpression = errors.New("cron expression uses constructs the provider cannot evaluate")
+
+// cronSearchYears limits the search for the next run, long enough to find runs on February 29.
+const cronSearchYears = 8
+
+// CronSchedule is a parsed cron expression. Every field is a bit set of the values it matches.
+type CronSchedule struct {
+	second, minute, hour, dayOfMonth, month, dayOfWeek uint64
+	dayOfMonthAny, dayOfWeekAny                        bool
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	cronSecond     = cronField{name: "second", min: 0, max: 59}
+	cronMinute     = cronField{name: "minute", min: 0, max: 59}
+	cronHour       = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth      = cronField{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6, "JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	cronDayOfWeek = cronField{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+var cronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// daysInMonth is the maximum number of days of each month, February counting leap years.
+var daysInMonth = [13]int{0, 31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+// ParseCronExpression parses a cron expression with five fields (minute, hour, day of month, month,
+// day of week) or six fields with a leading second field. Fields support lists, ranges, steps, month
+// and weekday names and ? for the day fields. Expressions that never run are rejected.
+func ParseCronExpression(expression string) (*CronSchedule, error) {
+	expression = strings.TrimSpace(expression)
+	if strings.HasPrefix(expression, "@") {
+		macro, ok := cronMacros[strings.ToLower(expression)]
+		if !ok {
+			return nil, fmt.Errorf("unknown macro %s", expression)
+		}
+		expression = macro
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != 5 && len(fields) != 6 {
+		return nil, fmt.Errorf("expected 5 fields (minute hour day-of-month month day-of-week) or 6 fields with leading seconds, got %d", len(fields))
+	}
+
+	if len(fields) == 5 {
+		fields = append([]string{"0"}, fields...)
+	}
+
+	for _, field := range fields[3:] {
+		if usesUnsupportedCronConstruct(field) {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedCronExpression, field)
+		}
+	}
+
+	schedule := &CronSchedule{}
+	var err error
+	parsers := []struct {
+		field  cronField
+		value  string
+		target *uint64
+		any    *bool
+	}{
+		{cronSecond, fields[0], &schedule.second, nil},
+		{cronMinute, fields[1], &schedule.minute, nil},
+		{cronHour, fields[2], &schedule.hour, nil},
+		{cronDayOfMonth, fields[3], &schedule.dayOfMonth, &schedule.dayOfMonthAny},
+		{cronMonth, fields[4], &schedule.month, nil},
+		{cronDayOfWeek, fields[5], &schedule.dayOfWeek, &schedule.dayOfWeekAny},
+	}
+
+	for _, parser := range parsers {
+		*parser.target, err = parseCronField(parser.field, parser.value, parser.any != nil)
+		if err != nil {
+			return nil, err
+		}
+		if parser.any != nil {
+			*parser.any = parser.value == "*" || parser.value == "?"
+		}
+	}
+
+	// Sunday may be written as 0 or 7.
+	if schedule.dayOfWeek&(1<<7) != 0 {
+		schedule.dayOfWeek |= 1
+	}
+
+	if !schedule.dayOfMonthAny && schedule.dayOfWeekAny && !schedule.hasDayInSelectedMonths() {
+		return nil, fmt.Errorf("the expression never runs, none of the selected days of month exist in the selected months")
+	}
+
+	return schedule, nil
+}
+
+// usesUnsupportedCronConstruct reports whether a day field uses L, W or #, ignoring month and weekday
+// names like JUL or WED.
+func usesUnsupportedCronConstruct(value string) bool {
+	value = strings.ToUpper(value)
+	for _, names := range []map[string]int{cronMonth.names, cronDayOfWeek.names} {
+		for name := range names {
+			value = strings.ReplaceAll(value, name, "")
+		}
+	}
+	return strings.ContainsAny(value, "LW#")
+}
+
+func parseCronField(field cronField, value string, allowQuestionMark bool) (uint64, error) {
+	if value == "?" {
+		if !allowQuestionMark {
+			return 0, fmt.Errorf("? is only allowed in the day of month and day of week fields")
+		}
+		value = "*"
+	}
+
+	var bits uint64
+	for _, element := range strings.Split(value, ",") {
+		if element == "" {
+			return 0, fmt.Errorf("empty element in %s field %q", field.name, value)
+		}
+
+		rangePart, stepPart, hasStep := strings.Cut(element, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepPart)
+			if err != nil || step < 1 {
+				return 0, fmt.Errorf("step %q in %s field must be a number greater than 0", stepPart, field.name)
+			}
+		}
+
+		start, end := field.min, field.max
+		if rangePart != "*" {
+			from, to, isRange := strings.Cut(rangePart, "-")
+
+			var err error
+			start, err = parseCronValue(field, from)
+			if err != nil {
+				return 0, err
+			}
+
+			end = start
+			if isRange {
+				end, err = parseCronValue(field, to)
+				if err != nil {
+					return 0, err
+				}
+				if end < start {
+					return 0, fmt.Errorf("range %s in %s field must not end before it starts", rangePart, field.name)
+				}
+			} else if hasStep {
+				end = field.max
+			}
+		}
+
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+
+	return bits, nil
+}
+
+func parseCronValue(field cronField, value string) (int, error) {
+	if number, ok := field.names[strings.ToUpper(value)]; ok {
+		return number, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid %s", value, field.name)
+	}
+	if number < field.min || number > field.max {
+		return 0, fmt.Errorf("%s %d is out of range %d-%d", field.name, number, field.min, field.max)
+	}
+
+	return number, nil
+}
+
+func (s *CronSchedule) hasDayInSelectedMonths() bool {
+	for month := 1; month <= 12; month++ {
+		if s.month&(1<<uint(month)) == 0 {
+			continue
+		}
+		for day := 1; day <= daysInMonth[month]; day++ {
+			if s.dayOfMonth&(1<<uint(day)) != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dayMatches follows cron's rule that a day matches either field if both day fields are restricted.
+func (s *CronSchedule) dayMatches(t time.Time) bool {
+	dayOfMonth := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dayOfWeek := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.dayOfMonthAny || s.dayOfWeekAny {
+		return dayOfMonth && dayOfWeek
+	}
+	return dayOfMonth || dayOfWeek
+}
+
+// Next returns the first run after t in the location of t, or false if there is none.
+func (s *CronSchedule) Next(t time.Time) (time.Time, bool) {
+	location := t.Location()
+	t = t.Truncate(time.Second).Add(time.Second)
+	yearLimit := t.Year() + cronSearchYears
+
+	// Once a field is advanced, the smaller fields are reset to their first value.
+	reset := false
+
+WRAP:
+	for t.Year() <= yearLimit {
+		for s.month&(1<<uint(t.Month())) == 0 {
+			if !reset {
+				reset = true
+				t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, location)
+			}
+			t = t.AddDate(0, 1, 0)
+			if t.Month() == time.January {
+				continue WRAP
+			}
+		}
+
+		for !s.dayMatches(t) {
+			if !reset {
+				reset = true
+				t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, location)
+			}
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, location)
+			if t.Day() == 1 {
+				continue WRAP
+			}
+		}
+
+		for s.hour&(1<<uint(t.Hour())) == 0 {
+			if !reset {
+				reset = true
+				t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, location)
+			}
+			t = t.Add(time.Hour)
+			if t.Hour() == 0 {
+				continue WRAP
+			}
+		}
+
+		for s.minute&(1<<uint(t.Minute())) == 0 {
+			if !reset {
+				reset = true
+				t = t.Truncate(time.Minute)
+			}
+			t = t.Add(time.Minute)
+			if t.Minute() == 0 {
+				continue WRAP
+			}
+		}
+
+		for s.second&(1<<uint(t.Second())) == 0 {
+			t = t.Add(time.Second)
+			if t.Second() == 0 {
+				continue WRAP
+			}
+		}
+
+		// A wall clock time repeated when the clock falls back only runs the first time.
+		if isRepeatedWallClock(t) {
+			t = t.Add(time.Second)
+			reset = false
+			continue WRAP
+		}
+
+		return t, true
+	}
+
+	return time.Time{}, false
+}
+
+// isRepeatedWallClock reports whether the wall clock time of t already occurred earlier, because the clock
+// was set back before t, e.g. when daylight saving time ends.
+func isRepeatedWallClock(t time.Time) bool {
+	_, offset := t.Zone()
+	// Offset changes are months apart, so the offset two days earlier is the one before any recent change.
+	_, previousOffset := t.Add(-48 * time.Hour).Zone()
+	if previousOffset <= offset {
+		return false
+	}
+
+	earlier := t.Add(-time.Duration(previousOffset-offset) * time.Second)
+	_, earlierOffset := earlier.Zone()
+	return earlierOffset == previousOffset
+}
+
+// NextRuns returns up to count runs after t.
+func (s *CronSchedule) NextRuns(t time.Time, count int) []time.Time {
+	var runs []time.Time
+	for len(runs) < count {
+		next, ok := s.Next(t)
+		if !ok {
+			break
+		}
+		runs = append(runs, next)
+		t = next
+	}
+	return runs
+}
+
+// MinInterval returns the shortest time between two of the next count runs after t.
+func (s *CronSchedule) MinInterval(t time.Time, count int) (time.Duration, bool) {
+	runs := s.NextRuns(t, count)
+	if len(runs) < 2 {
+		return 0, false
+	}
+
+	interval := runs[1].Sub(runs[0])
+	for i := 2; i < len(runs); i++ {
+		interval = min(interval, runs[i].Sub(runs[i-1]))
+	}
+	return interval, true
+}
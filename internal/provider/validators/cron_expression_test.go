@@ -0,0 +1,120 @@
+package validators
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseCronExpression(t *testing.T) {
+	tests := []struct {
+		name        string
+		expression  string
+		wantErr     bool
+		unsupported bool
+	}{
+		{name: "five fields", expression: "*/5 * * * *"},
+		{name: "six fields", expression: "30 */10 * * * *"},
+		{name: "names", expression: "0 9 * JAN-MAR MON-FRI"},
+		{name: "question mark", expression: "0 0 1 * ?"},
+		{name: "sunday as 7", expression: "0 0 * * 7"},
+		{name: "macro", expression: "@daily"},
+		{name: "unknown macro", expression: "@fortnightly", wantErr: true},
+		{name: "too few fields", expression: "* * * *", wantErr: true},
+		{name: "zero step", expression: "*/0 * * * *", wantErr: true},
+		{name: "out of range", expression: "60 * * * *", wantErr: true},
+		{name: "reversed range", expression: "0 10-5 * * *", wantErr: true},
+		{name: "question mark in hour", expression: "0 ? * * *", wantErr: true},
+		{name: "never runs", expression: "0 0 31 4 *", wantErr: true},
+		{name: "last day of month", expression: "0 0 L * *", wantErr: true, unsupported: true},
+		{name: "nth weekday", expression: "0 0 * * MON#2", wantErr: true, unsupported: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseCronExpression(test.expression)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ParseCronExpression(%q) error = %v, want error %t", test.expression, err, test.wantErr)
+			}
+			if errors.Is(err, ErrUnsupportedCronExpression) != test.unsupported {
+				t.Fatalf("ParseCronExpression(%q) error = %v, want unsupported %t", test.expression, err, test.unsupported)
+			}
+		})
+	}
+}
+
+func TestCronScheduleNextRuns(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		from       time.Time
+		want       []string
+	}{
+		{
+			name:       "weekdays",
+			expression: "0 9 * * MON-FRI",
+			from:       time.Date(2025, 3, 28, 12, 0, 0, 0, time.UTC),
+			want:       []string{"2025-03-31T09:00:00Z", "2025-04-01T09:00:00Z", "2025-04-02T09:00:00Z"},
+		},
+		{
+			name:       "seconds",
+			expression: "30 */10 * * * *",
+			from:       time.Date(2025, 1, 1, 0, 0, 30, 0, time.UTC),
+			want:       []string{"2025-01-01T00:10:30Z", "2025-01-01T00:20:30Z", "2025-01-01T00:30:30Z"},
+		},
+		{
+			name:       "either day field",
+			expression: "0 0 13 * FRI",
+			from:       time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			want:       []string{"2025-06-06T00:00:00Z", "2025-06-13T00:00:00Z", "2025-06-20T00:00:00Z"},
+		},
+		{
+			name:       "leap day",
+			expression: "0 0 29 2 *",
+			from:       time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			want:       []string{"2028-02-29T00:00:00Z", "2032-02-29T00:00:00Z"},
+		},
+		{
+			name:       "skipped when the clock springs forward",
+			expression: "30 2 * * *",
+			from:       time.Date(2026, 3, 28, 12, 0, 0, 0, berlin),
+			want:       []string{"2026-03-30T02:30:00+02:00", "2026-03-31T02:30:00+02:00"},
+		},
+		{
+			name:       "once when the clock falls back",
+			expression: "30 2 * * *",
+			from:       time.Date(2026, 10, 24, 12, 0, 0, 0, berlin),
+			want:       []string{"2026-10-25T02:30:00+02:00", "2026-10-26T02:30:00+01:00"},
+		},
+		{
+			name:       "repeated hour skipped when the clock falls back",
+			expression: "0 */30 * * * *",
+			from:       time.Date(2026, 10, 25, 0, 15, 0, 0, time.UTC).In(berlin),
+			want:       []string{"2026-10-25T02:30:00+02:00", "2026-10-25T03:00:00+01:00", "2026-10-25T03:30:00+01:00"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule, err := ParseCronExpression(test.expression)
+			if err != nil {
+				t.Fatalf("ParseCronExpression(%q) error = %v", test.expression, err)
+			}
+
+			runs := schedule.NextRuns(test.from, len(test.want))
+			if len(runs) != len(test.want) {
+				t.Fatalf("NextRuns() = %v, want %v", runs, test.want)
+			}
+			for i, run := range runs {
+				if got := run.Format(time.RFC3339); got != test.want[i] {
+					t.Errorf("NextRuns()[%d] = %s, want %s", i, got, test.want[i])
+				}
+			}
+		})
+	}
+}
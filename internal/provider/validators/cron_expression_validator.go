@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type cronExpressionValidator struct {
+	message string
+}
+
+func (v cronExpressionValidator) Description(_ context.Context) string {
+	return v.message
+}
+
+func (v cronExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v cronExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := ParseCronExpression(request.ConfigValue.ValueString())
+	if err == nil {
+		return
+	}
+
+	if errors.Is(err, ErrUnsupportedCronExpression) {
+		response.Diagnostics.AddAttributeWarning(
+			request.Path,
+			"Unverified Cron Expression",
+			fmt.Sprintf("%s. The expression cannot be checked at plan time and next runs are not computed.", err.Error()),
+		)
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid Cron Expression",
+		fmt.Sprintf("%s: %s", v.message, err.Error()),
+	)
+}
+
+// CronExpression returns a validator that ensures the value is a cron expression with five fields or six
+// fields including seconds that runs at least once. Expressions using L, W or # only cause a warning.
+func CronExpression(message string) cronExpressionValidator {
+	return cronExpressionValidator{
+		message: message,
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
 
+// DateTimeLayout is the layout of UTC date times accepted by the DateTime validator.
+const DateTimeLayout = "2006-01-02T15:04:05Z"
+
 type dateTimeValidator struct {
 	message string
 }
@@ -25,7 +28,7 @@ func (v dateTimeValidator) ValidateString(ctx context.Context, request validator
 		return
 	}
 
-	_, err := time.Parse("2006-01-02T15:04:05Z", request.ConfigValue.ValueString())
+	_, err := time.Parse(DateTimeLayout, request.ConfigValue.ValueString())
 	if err != nil {
 		response.Diagnostics.AddAttributeError(
 			request.Path,
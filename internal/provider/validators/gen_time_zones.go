@@ -0,0 +1,36 @@
+//go:build ignore
+
+// This program generates time_zones.txt from the zoneinfo.zip of the Go installation,
+// which is the archive time/tzdata embeds. Run it with go generate after updating Go.
+package main
+
+import (
+	"archive/zip"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+func main() {
+	archive, err := zip.OpenReader(filepath.Join(runtime.GOROOT(), "lib", "time", "zoneinfo.zip"))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer archive.Close()
+
+	var names []string
+	for _, file := range archive.File {
+		if !file.FileInfo().IsDir() {
+			names = append(names, file.Name)
+		}
+	}
+	sort.Strings(names)
+
+	err = os.WriteFile("time_zones.txt", []byte(strings.Join(names, "\n")+"\n"), 0o644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
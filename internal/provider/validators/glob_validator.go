@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type globValidator struct {
+	message string
+}
+
+func (v globValidator) Description(_ context.Context) string {
+	return v.message
+}
+
+func (v globValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v globValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := path.Match(request.ConfigValue.ValueString(), "")
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Glob Pattern",
+			fmt.Sprintf("%s: %s", v.message, err.Error()),
+		)
+	}
+}
+
+// Glob returns a validator that ensures the value is a glob pattern
+// with the syntax of Go's path.Match.
+func Glob(message string) globValidator {
+	return globValidator{
+		message: message,
+	}
+}
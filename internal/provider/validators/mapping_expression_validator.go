@@ -0,0 +1,166 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type mappingExpressionValidator struct {
+	message        string
+	summary        string
+	warningSummary string
+	parse          func(text string) error
+}
+
+func (v mappingExpressionValidator) Description(_ context.Context) string {
+	return v.message
+}
+
+func (v mappingExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v mappingExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	err := v.parse(request.ConfigValue.ValueString())
+	if err == nil {
+		return
+	}
+
+	if errors.Is(err, ErrUnsupportedMappingExpression) {
+		response.Diagnostics.AddAttributeWarning(
+			request.Path,
+			v.warningSummary,
+			fmt.Sprintf("%s. The expression cannot be checked at plan time and test payloads are not evaluated against it.", err.Error()),
+		)
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		v.summary,
+		fmt.Sprintf("%s: %s", v.message, err.Error()),
+	)
+}
+
+// JSONPath returns a validator that ensures the value is a well-formed JSONPath expression. Expressions the
+// mapping evaluator cannot parse, like filters, only cause a warning.
+func JSONPath(message string) mappingExpressionValidator {
+	return mappingExpressionValidator{
+		message:        message,
+		summary:        "Invalid JSONPath Expression",
+		warningSummary: "Unverified JSONPath Expression",
+		parse: func(text string) error {
+			_, err := ParseJSONPath(text)
+			return err
+		},
+	}
+}
+
+// XPath returns a validator that ensures the value is a well-formed XPath expression. Expressions the
+// mapping evaluator cannot parse, like functions, only cause a warning.
+func XPath(message string) mappingExpressionValidator {
+	return mappingExpressionValidator{
+		message:        message,
+		summary:        "Invalid XPath Expression",
+		warningSummary: "Unverified XPath Expression",
+		parse: func(text string) error {
+			_, err := ParseXPath(text)
+			return err
+		},
+	}
+}
+
+// MapExpression returns a validator that ensures the value is a map expression like A->1,B->2,->3
+// with unique keys and at most one fallback as the last entry.
+func MapExpression(message string) mappingExpressionValidator {
+	return mappingExpressionValidator{
+		message: message,
+		summary: "Invalid Map Expression",
+		parse: func(text string) error {
+			_, err := ParseMapExpression(text)
+			return err
+		},
+	}
+}
+
+// dotNetOnlyRegexConstructs are constructs of the .NET regex flavor that Go's RE2 syntax does not support.
+var dotNetOnlyRegexConstructs = []struct {
+	pattern *regexp.Regexp
+	name    string
+}{
+	{regexp.MustCompile(`\(\?<[=!]`), "a lookbehind"},
+	{regexp.MustCompile(`\(\?[=!]`), "a lookahead"},
+	{regexp.MustCompile(`\(\?>`), "an atomic group"},
+	{regexp.MustCompile(`\(\?\(`), "a conditional"},
+	{regexp.MustCompile(`\(\?'`), "a quoted group name"},
+	{regexp.MustCompile(`\(\?<[\p{L}\p{N}_]*-[\p{L}\p{N}_]+>`), "a balancing group"},
+	{regexp.MustCompile(`\\k[<']|\\[1-9]`), "a backreference"},
+	{regexp.MustCompile(`\\[GZ]`), "the \\G or \\Z anchor"},
+	{regexp.MustCompile(`\(\?[imsU]*[nx]`), "an inline option"},
+}
+
+// DotNetOnlyRegexConstruct returns the name of the first construct of the regex that only the .NET flavor
+// supports, or an empty string if there is none.
+func DotNetOnlyRegexConstruct(text string) string {
+	for _, construct := range dotNetOnlyRegexConstructs {
+		if construct.pattern.MatchString(text) {
+			return construct.name
+		}
+	}
+
+	return ""
+}
+
+type mappingRegexValidator struct {
+	message string
+}
+
+func (v mappingRegexValidator) Description(_ context.Context) string {
+	return v.message
+}
+
+func (v mappingRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v mappingRegexValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := regexp.Compile(request.ConfigValue.ValueString())
+	if err == nil {
+		return
+	}
+
+	if construct := DotNetOnlyRegexConstruct(request.ConfigValue.ValueString()); construct != "" {
+		response.Diagnostics.AddAttributeWarning(
+			request.Path,
+			"Unverified Regular Expression",
+			fmt.Sprintf("The regular expression uses %s, which is only supported by the .NET flavor. It cannot be checked at plan time and test payloads are not evaluated against it.", construct),
+		)
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid Regular Expression",
+		fmt.Sprintf("%s: %s", v.message, err.Error()),
+	)
+}
+
+// MappingRegex returns a validator that ensures the value is a regular expression of the .NET flavor.
+// Expressions that compile with Go's RE2 syntax are valid, those using .NET-only constructs only cause a warning.
+func MappingRegex(message string) mappingRegexValidator {
+	return mappingRegexValidator{
+		message: message,
+	}
+}
@@ -0,0 +1,773 @@
+package validators
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The expressions of integration mappings are parsed here, so that they can be validated at plan time and
+// evaluated by the provider's mapping evaluator alike.
+
+// ErrUnsupportedMappingExpression is returned for JSONPath and XPath expressions that are well-formed but use
+// constructs the provider cannot evaluate, like filters or functions. All Quiet evaluates them nonetheless.
+var ErrUnsupportedMappingExpression = errors.New("expression uses constructs the provider cannot evaluate")
+
+// DecodeJSONDocument decodes a single JSON document, keeping numbers as they are.
+func DecodeJSONDocument(value string) (any, bool) {
+	decoder := json.NewDecoder(strings.NewReader(value))
+	decoder.UseNumber()
+
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, false
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return nil, false
+	}
+
+	return document, true
+}
+
+// JSONValueToString returns strings as they are and any other JSON value serialized.
+func JSONValueToString(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return typed
+	case json.Number:
+		return typed.String()
+	case bool:
+		return strconv.FormatBool(typed)
+	default:
+		var buffer bytes.Buffer
+		encoder := json.NewEncoder(&buffer)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(typed); err != nil {
+			return ""
+		}
+		return strings.TrimSuffix(buffer.String(), "\n")
+	}
+}
+
+// ParsedMapExpression is a parsed map expression like A->1,B->2,->3.
+type ParsedMapExpression struct {
+	entries  map[string]string
+	fallback *string
+}
+
+// ParseMapExpression parses a map expression. Keys must be unique and the fallback, an entry without key,
+// can only be given once as the last entry.
+func ParseMapExpression(text string) (*ParsedMapExpression, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, errors.New("empty expression")
+	}
+
+	expression := &ParsedMapExpression{entries: map[string]string{}}
+
+	for _, entry := range strings.Split(text, ",") {
+		if strings.TrimSpace(entry) == "" {
+			return nil, errors.New("empty entry, entries must be separated by a single ','")
+		}
+
+		if expression.fallback != nil {
+			return nil, fmt.Errorf("entry %q follows the fallback, the fallback must be the last entry", entry)
+		}
+
+		from, to, ok := strings.Cut(entry, "->")
+		if !ok {
+			return nil, fmt.Errorf("entry %q is missing '->'", entry)
+		}
+
+		if strings.Contains(to, "->") {
+			return nil, fmt.Errorf("entry %q contains more than one '->'", entry)
+		}
+
+		from = strings.TrimSpace(from)
+		to = strings.TrimSpace(to)
+		if from == "" {
+			expression.fallback = &to
+			continue
+		}
+
+		if _, ok := expression.entries[from]; ok {
+			return nil, fmt.Errorf("duplicate key %q", from)
+		}
+
+		expression.entries[from] = to
+	}
+
+	return expression, nil
+}
+
+// Evaluate returns the mapped value, the fallback if no key matches or otherwise the value itself.
+func (e *ParsedMapExpression) Evaluate(value string) string {
+	if mapped, ok := e.entries[value]; ok {
+		return mapped
+	}
+
+	if e.fallback != nil {
+		return *e.fallback
+	}
+
+	return value
+}
+
+// ParsedJSONPath is a parsed JSONPath expression.
+type ParsedJSONPath struct {
+	selectors []jsonPathSelector
+}
+
+type jsonPathSelector struct {
+	recursive bool
+	wildcard  bool
+	names     []string
+	indices   []int
+}
+
+// ParseJSONPath parses a JSONPath expression with child, recursive descent, wildcard and index selectors.
+// Malformed expressions are an error, any other expression beyond this subset is wrapped in
+// ErrUnsupportedMappingExpression.
+func ParseJSONPath(text string) (*ParsedJSONPath, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, errors.New("empty expression")
+	}
+
+	if err := checkBalanced(text); err != nil {
+		return nil, err
+	}
+
+	path, err := parseJSONPath(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMappingExpression, err.Error())
+	}
+
+	return path, nil
+}
+
+func parseJSONPath(text string) (*ParsedJSONPath, error) {
+	if !strings.HasPrefix(text, "$") {
+		return nil, errors.New("must start with '$'")
+	}
+
+	path := &ParsedJSONPath{}
+	for i := 1; i < len(text); {
+		selector := jsonPathSelector{}
+
+		switch {
+		case strings.HasPrefix(text[i:], ".."):
+			selector.recursive = true
+			i += 2
+		case text[i] == '.':
+			i++
+		case text[i] == '[':
+		default:
+			return nil, fmt.Errorf("unexpected %q at position %d", text[i], i)
+		}
+
+		if i >= len(text) {
+			return nil, errors.New("unexpected end of expression")
+		}
+
+		if text[i] == '[' {
+			end := indexOutsideQuotes(text[i:], ']')
+			if end < 0 {
+				return nil, errors.New("missing ']'")
+			}
+
+			err := parseJSONPathBracket(strings.TrimSpace(text[i+1:i+end]), &selector)
+			if err != nil {
+				return nil, err
+			}
+			i += end + 1
+		} else {
+			end := strings.IndexAny(text[i:], ".[")
+			if end < 0 {
+				end = len(text) - i
+			}
+
+			name := text[i : i+end]
+			if name == "" {
+				return nil, fmt.Errorf("missing name at position %d", i)
+			}
+
+			if name == "*" {
+				selector.wildcard = true
+			} else {
+				selector.names = []string{name}
+			}
+			i += end
+		}
+
+		path.selectors = append(path.selectors, selector)
+	}
+
+	return path, nil
+}
+
+func parseJSONPathBracket(content string, selector *jsonPathSelector) error {
+	if content == "*" {
+		selector.wildcard = true
+		return nil
+	}
+
+	if strings.HasPrefix(content, "?") || strings.HasPrefix(content, "(") {
+		return fmt.Errorf("filters and scripts are not supported: [%s]", content)
+	}
+
+	for _, part := range splitOutsideQuotes(content, ',') {
+		part = strings.TrimSpace(part)
+		if len(part) >= 2 && (part[0] == '\'' || part[0] == '"') && part[len(part)-1] == part[0] {
+			selector.names = append(selector.names, part[1:len(part)-1])
+			continue
+		}
+
+		index, err := strconv.Atoi(part)
+		if err != nil {
+			return fmt.Errorf("invalid selector [%s]", content)
+		}
+		selector.indices = append(selector.indices, index)
+	}
+
+	return nil
+}
+
+// Evaluate returns the first match in the JSON document, or an empty value if the value is not JSON.
+func (p *ParsedJSONPath) Evaluate(value string) string {
+	document, ok := DecodeJSONDocument(value)
+	if !ok {
+		return ""
+	}
+
+	nodes := []any{document}
+	for _, selector := range p.selectors {
+		if selector.recursive {
+			var descendants []any
+			for _, node := range nodes {
+				descendants = appendJSONDescendants(descendants, node)
+			}
+			nodes = descendants
+		}
+
+		var next []any
+		for _, node := range nodes {
+			next = append(next, selector.apply(node)...)
+		}
+		nodes = next
+	}
+
+	if len(nodes) == 0 {
+		return ""
+	}
+
+	return JSONValueToString(nodes[0])
+}
+
+func (s jsonPathSelector) apply(node any) []any {
+	var result []any
+
+	switch typed := node.(type) {
+	case map[string]any:
+		if s.wildcard {
+			keys := make([]string, 0, len(typed))
+			for key := range typed {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				result = append(result, typed[key])
+			}
+		}
+		for _, name := range s.names {
+			if element, ok := typed[name]; ok {
+				result = append(result, element)
+			}
+		}
+	case []any:
+		if s.wildcard {
+			result = append(result, typed...)
+		}
+		for _, index := range s.indices {
+			if index < 0 {
+				index += len(typed)
+			}
+			if index >= 0 && index < len(typed) {
+				result = append(result, typed[index])
+			}
+		}
+	}
+
+	return result
+}
+
+func appendJSONDescendants(result []any, node any) []any {
+	result = append(result, node)
+
+	switch typed := node.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			result = appendJSONDescendants(result, typed[key])
+		}
+	case []any:
+		for _, element := range typed {
+			result = appendJSONDescendants(result, element)
+		}
+	}
+
+	return result
+}
+
+// ParsedXPath is a parsed XPath expression.
+type ParsedXPath struct {
+	steps []xPathStep
+}
+
+type xPathStep struct {
+	descendant bool
+	// test is an element name, '*', '@name', '@*', 'text()', 'node()', '.' or '..'
+	test       string
+	predicates []xPathPredicate
+}
+
+type xPathPredicate struct {
+	// index is the 1-based position, -1 for last()
+	index int
+	// name is an attribute (@name) or child element name compared to value, if set
+	name     string
+	value    *string
+	hasIndex bool
+}
+
+type xmlNode struct {
+	name     string
+	attrs    []xml.Attr
+	parent   *xmlNode
+	children []*xmlNode
+	ownText  strings.Builder
+	allText  strings.Builder
+}
+
+// ParseXPath parses an XPath expression with child, descendant, attribute and text() steps and index,
+// last() and equality predicates. Malformed expressions are an error, any other expression beyond this
+// subset is wrapped in ErrUnsupportedMappingExpression.
+func ParseXPath(text string) (*ParsedXPath, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, errors.New("empty expression")
+	}
+
+	if err := checkBalanced(text); err != nil {
+		return nil, err
+	}
+
+	path, err := parseXPath(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMappingExpression, err.Error())
+	}
+
+	return path, nil
+}
+
+func parseXPath(text string) (*ParsedXPath, error) {
+	path := &ParsedXPath{}
+	i := 0
+	for i < len(text) {
+		step := xPathStep{}
+
+		switch {
+		case strings.HasPrefix(text[i:], "//"):
+			step.descendant = true
+			i += 2
+		case text[i] == '/':
+			i++
+		case i > 0:
+			return nil, fmt.Errorf("unexpected %q at position %d", text[i], i)
+		}
+
+		end := indexOutsideQuotes(text[i:], '/')
+		if end < 0 {
+			end = len(text) - i
+		}
+
+		err := parseXPathStep(strings.TrimSpace(text[i:i+end]), &step)
+		if err != nil {
+			return nil, err
+		}
+
+		path.steps = append(path.steps, step)
+		i += end
+	}
+
+	return path, nil
+}
+
+var xPathNamePattern = regexp.MustCompile(`^(@?(\*|[\p{L}_][\p{L}\p{N}_.\-]*(:[\p{L}_][\p{L}\p{N}_.\-]*)?)|text\(\)|node\(\)|\.|\.\.)$`)
+
+func parseXPathStep(text string, step *xPathStep) error {
+	test := text
+	if open := strings.IndexByte(text, '['); open >= 0 {
+		test = text[:open]
+		rest := text[open:]
+
+		for rest != "" {
+			if rest[0] != '[' {
+				return fmt.Errorf("unexpected %q in step %q", rest, text)
+			}
+
+			end := indexOutsideQuotes(rest, ']')
+			if end < 0 {
+				return fmt.Errorf("missing ']' in step %q", text)
+			}
+
+			predicate, err := parseXPathPredicate(strings.TrimSpace(rest[1:end]))
+			if err != nil {
+				return err
+			}
+
+			step.predicates = append(step.predicates, predicate)
+			rest = rest[end+1:]
+		}
+	}
+
+	test = strings.TrimSpace(test)
+	if !xPathNamePattern.MatchString(test) {
+		return fmt.Errorf("unsupported step %q", text)
+	}
+
+	// Namespaces are ignored, names are matched by their local part
+	if colon := strings.LastIndexByte(test, ':'); colon >= 0 {
+		prefix := ""
+		if strings.HasPrefix(test, "@") {
+			prefix = "@"
+		}
+		test = prefix + test[colon+1:]
+	}
+
+	step.test = test
+	return nil
+}
+
+func parseXPathPredicate(text string) (xPathPredicate, error) {
+	if text == "last()" {
+		return xPathPredicate{index: -1, hasIndex: true}, nil
+	}
+
+	if index, err := strconv.Atoi(text); err == nil {
+		if index < 1 {
+			return xPathPredicate{}, fmt.Errorf("position must be at least 1: [%s]", text)
+		}
+		return xPathPredicate{index: index, hasIndex: true}, nil
+	}
+
+	name, value, hasValue := strings.Cut(text, "=")
+	name = strings.TrimSpace(name)
+	if !xPathNamePattern.MatchString(name) || strings.HasSuffix(name, "()") || strings.HasPrefix(name, ".") {
+		return xPathPredicate{}, fmt.Errorf("unsupported predicate [%s]", text)
+	}
+
+	predicate := xPathPredicate{name: name}
+	if hasValue {
+		value = strings.TrimSpace(value)
+		if len(value) < 2 || (value[0] != '\'' && value[0] != '"') || value[len(value)-1] != value[0] {
+			return xPathPredicate{}, fmt.Errorf("unsupported predicate [%s], values must be quoted", text)
+		}
+		unquoted := value[1 : len(value)-1]
+		predicate.value = &unquoted
+	}
+
+	return predicate, nil
+}
+
+// Evaluate returns the string value of the first match in the XML or HTML document, or an empty value
+// if the value is not a document.
+func (p *ParsedXPath) Evaluate(value string) string {
+	document, ok := parseXMLDocument(value)
+	if !ok {
+		return ""
+	}
+
+	nodes := []*xmlNode{document}
+	for _, step := range p.steps {
+		if strings.HasPrefix(step.test, "@") || step.test == "text()" {
+			values := selectXPathValues(nodes, step)
+			if len(values) == 0 {
+				return ""
+			}
+			return values[0]
+		}
+
+		nodes = selectXPathNodes(nodes, step)
+	}
+
+	if len(nodes) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(nodes[0].allText.String())
+}
+
+func xPathCandidates(node *xmlNode, descendant bool) []*xmlNode {
+	if !descendant {
+		return []*xmlNode{node}
+	}
+
+	result := []*xmlNode{node}
+	for _, child := range node.children {
+		result = append(result, xPathCandidates(child, true)...)
+	}
+
+	return result
+}
+
+func selectXPathNodes(nodes []*xmlNode, step xPathStep) []*xmlNode {
+	var result []*xmlNode
+
+	for _, node := range nodes {
+		for _, candidate := range xPathCandidates(node, step.descendant) {
+			var matches []*xmlNode
+			switch step.test {
+			case ".":
+				matches = []*xmlNode{candidate}
+			case "..":
+				if candidate.parent != nil {
+					matches = []*xmlNode{candidate.parent}
+				}
+			default:
+				for _, child := range candidate.children {
+					if step.test == "*" || step.test == "node()" || child.name == step.test {
+						matches = append(matches, child)
+					}
+				}
+			}
+
+			for _, predicate := range step.predicates {
+				matches = predicate.filter(matches)
+			}
+
+			for _, match := range matches {
+				if !containsXMLNode(result, match) {
+					result = append(result, match)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func selectXPathValues(nodes []*xmlNode, step xPathStep) []string {
+	var result []string
+
+	for _, node := range nodes {
+		for _, candidate := range xPathCandidates(node, step.descendant) {
+			if step.test == "text()" {
+				if text := strings.TrimSpace(candidate.ownText.String()); text != "" {
+					result = append(result, text)
+				}
+				continue
+			}
+
+			for _, attr := range candidate.attrs {
+				if step.test == "@*" || "@"+attr.Name.Local == step.test {
+					result = append(result, attr.Value)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+func (p xPathPredicate) filter(nodes []*xmlNode) []*xmlNode {
+	if p.hasIndex {
+		index := p.index
+		if index < 0 {
+			index = len(nodes)
+		}
+		if index < 1 || index > len(nodes) {
+			return nil
+		}
+		return []*xmlNode{nodes[index-1]}
+	}
+
+	var result []*xmlNode
+	for _, node := range nodes {
+		if p.matches(node) {
+			result = append(result, node)
+		}
+	}
+
+	return result
+}
+
+func (p xPathPredicate) matches(node *xmlNode) bool {
+	if strings.HasPrefix(p.name, "@") {
+		for _, attr := range node.attrs {
+			if p.name == "@*" || "@"+attr.Name.Local == p.name {
+				if p.value == nil || attr.Value == *p.value {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	for _, child := range node.children {
+		if p.name == "*" || child.name == p.name {
+			if p.value == nil || strings.TrimSpace(child.allText.String()) == *p.value {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func containsXMLNode(nodes []*xmlNode, node *xmlNode) bool {
+	for _, n := range nodes {
+		if n == node {
+			return true
+		}
+	}
+	return false
+}
+
+// parseXMLDocument parses XML leniently, so that HTML can be mapped as well.
+func parseXMLDocument(value string) (*xmlNode, bool) {
+	if !strings.HasPrefix(strings.TrimSpace(value), "<") {
+		return nil, false
+	}
+
+	decoder := xml.NewDecoder(strings.NewReader(value))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	document := &xmlNode{}
+	current := document
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, false
+		}
+
+		switch typed := token.(type) {
+		case xml.StartElement:
+			node := &xmlNode{name: typed.Name.Local, attrs: typed.Attr, parent: current}
+			current.children = append(current.children, node)
+			current = node
+		case xml.EndElement:
+			// Lenient parsing may report end elements that were never opened
+			for node := current; node != nil && node.parent != nil; node = node.parent {
+				if node.name == typed.Name.Local {
+					current = node.parent
+					break
+				}
+			}
+		case xml.CharData:
+			current.ownText.Write(typed)
+			for node := current; node != nil; node = node.parent {
+				node.allText.Write(typed)
+			}
+		}
+	}
+
+	if len(document.children) == 0 {
+		return nil, false
+	}
+
+	return document, true
+}
+
+// checkBalanced returns an error if the quotes, brackets or parentheses of the expression are not balanced.
+func checkBalanced(text string) error {
+	var quote byte
+	quoteStart := 0
+	var open []int
+	for i := 0; i < len(text); i++ {
+		switch {
+		case quote != 0:
+			if text[i] == quote {
+				quote = 0
+			}
+		case text[i] == '\'' || text[i] == '"':
+			quote = text[i]
+			quoteStart = i
+		case text[i] == '[' || text[i] == '(':
+			open = append(open, i)
+		case text[i] == ']' || text[i] == ')':
+			expected := byte('[')
+			if text[i] == ')' {
+				expected = '('
+			}
+			if len(open) == 0 || text[open[len(open)-1]] != expected {
+				return fmt.Errorf("unbalanced %q at position %d", text[i], i)
+			}
+			open = open[:len(open)-1]
+		}
+	}
+
+	if quote != 0 {
+		return fmt.Errorf("unterminated quote at position %d", quoteStart)
+	}
+
+	if len(open) > 0 {
+		return fmt.Errorf("unclosed %q at position %d", text[open[len(open)-1]], open[len(open)-1])
+	}
+
+	return nil
+}
+
+// indexOutsideQuotes returns the index of the first c that is not within single or double quotes or brackets.
+func indexOutsideQuotes(text string, c byte) int {
+	var quote byte
+	depth := 0
+	for i := 0; i < len(text); i++ {
+		switch {
+		case quote != 0:
+			if text[i] == quote {
+				quote = 0
+			}
+		case text[i] == '\'' || text[i] == '"':
+			quote = text[i]
+		case text[i] == c && (depth == 0 || (c == ']' && depth == 1)):
+			return i
+		case text[i] == '[':
+			depth++
+		case text[i] == ']':
+			depth--
+		}
+	}
+
+	return -1
+}
+
+func splitOutsideQuotes(text string, separator byte) []string {
+	var parts []string
+	for {
+		index := indexOutsideQuotes(text, separator)
+		if index < 0 {
+			return append(parts, text)
+		}
+		parts = append(parts, text[:index])
+		text = text[index+1:]
+	}
+}
@@ -0,0 +1,135 @@
+package validators
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseJSONPath(t *testing.T) {
+	document := `{"alerts":[{"labels":{"severity":"critical","host":"web-1"}},{"labels":{"severity":"warning"}}],"count":2}`
+
+	tests := []struct {
+		name        string
+		expression  string
+		want        string
+		wantErr     bool
+		unsupported bool
+	}{
+		{name: "child", expression: "$.count", want: "2"},
+		{name: "index", expression: "$.alerts[1].labels.severity", want: "warning"},
+		{name: "negative index", expression: "$.alerts[-1].labels.severity", want: "warning"},
+		{name: "quoted name", expression: "$['alerts'][0]['labels']['host']", want: "web-1"},
+		{name: "recursive descent", expression: "$..host", want: "web-1"},
+		{name: "wildcard", expression: "$.alerts[*].labels.severity", want: "critical"},
+		{name: "object", expression: "$.alerts[0].labels", want: `{"host":"web-1","severity":"critical"}`},
+		{name: "no match", expression: "$.missing", want: ""},
+		{name: "empty", expression: " ", wantErr: true},
+		{name: "unclosed bracket", expression: "$.alerts[0.labels", wantErr: true},
+		{name: "unbalanced bracket", expression: "$.alerts]0[", wantErr: true},
+		{name: "unterminated quote", expression: "$['alerts]", wantErr: true},
+		{name: "filter", expression: "$.alerts[?(@.labels.severity == 'critical')]", wantErr: true, unsupported: true},
+		{name: "without root", expression: "alerts[0]", wantErr: true, unsupported: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, err := ParseJSONPath(test.expression)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ParseJSONPath(%q) error = %v, want error %t", test.expression, err, test.wantErr)
+			}
+			if errors.Is(err, ErrUnsupportedMappingExpression) != test.unsupported {
+				t.Fatalf("ParseJSONPath(%q) error = %v, want unsupported %t", test.expression, err, test.unsupported)
+			}
+			if err != nil {
+				return
+			}
+
+			if got := path.Evaluate(document); got != test.want {
+				t.Errorf("Evaluate() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseXPath(t *testing.T) {
+	document := `<alerts><alert id="1" severity="critical"><host>web-1</host></alert><alert id="2"><host>web-2</host></alert></alerts>`
+
+	tests := []struct {
+		name        string
+		expression  string
+		want        string
+		wantErr     bool
+		unsupported bool
+	}{
+		{name: "child", expression: "/alerts/alert/host", want: "web-1"},
+		{name: "descendant", expression: "//host", want: "web-1"},
+		{name: "index", expression: "//alert[2]/host", want: "web-2"},
+		{name: "last", expression: "//alert[last()]/@id", want: "2"},
+		{name: "attribute", expression: "//alert/@severity", want: "critical"},
+		{name: "attribute predicate", expression: "//alert[@id='2']/host/text()", want: "web-2"},
+		{name: "child predicate", expression: "//alert[host=\"web-2\"]/@id", want: "2"},
+		{name: "no match", expression: "//missing", want: ""},
+		{name: "empty", expression: "", wantErr: true},
+		{name: "unclosed bracket", expression: "//alert[@id='1'", wantErr: true},
+		{name: "unterminated quote", expression: "//alert[@id='1]", wantErr: true},
+		{name: "unbalanced parenthesis", expression: "//alert[last())]", wantErr: true},
+		{name: "function", expression: "//alert[contains(., 'x')]", wantErr: true, unsupported: true},
+		{name: "axis", expression: "//host/ancestor::alert/@id", wantErr: true, unsupported: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			path, err := ParseXPath(test.expression)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ParseXPath(%q) error = %v, want error %t", test.expression, err, test.wantErr)
+			}
+			if errors.Is(err, ErrUnsupportedMappingExpression) != test.unsupported {
+				t.Fatalf("ParseXPath(%q) error = %v, want unsupported %t", test.expression, err, test.unsupported)
+			}
+			if err != nil {
+				return
+			}
+
+			if got := path.Evaluate(document); got != test.want {
+				t.Errorf("Evaluate() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseMapExpression(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression string
+		value      string
+		want       string
+		wantErr    bool
+	}{
+		{name: "match", expression: "A->1,B->2,->3", value: "B", want: "2"},
+		{name: "fallback", expression: "A->1,B->2,->3", value: "C", want: "3"},
+		{name: "without fallback", expression: "A->1,B->2", value: "C", want: "C"},
+		{name: "spaces", expression: " A -> 1 , B -> 2 ", value: "A", want: "1"},
+		{name: "empty", expression: " ", wantErr: true},
+		{name: "empty entry", expression: "A->1,,B->2", wantErr: true},
+		{name: "missing arrow", expression: "A->1,B", wantErr: true},
+		{name: "two arrows", expression: "A->1->2", wantErr: true},
+		{name: "duplicate key", expression: "A->1,A->2", wantErr: true},
+		{name: "fallback not last", expression: "->3,A->1", wantErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			expression, err := ParseMapExpression(test.expression)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("ParseMapExpression(%q) error = %v, want error %t", test.expression, err, test.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if got := expression.Evaluate(test.value); got != test.want {
+				t.Errorf("Evaluate(%q) = %q, want %q", test.value, got, test.want)
+			}
+		})
+	}
+}
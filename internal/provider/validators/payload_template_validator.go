@@ -0,0 +1,63 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// payloadTemplateFuncs are the functions available in webhook payload templates in addition to
+// the builtin functions of text/template.
+var payloadTemplateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// ParsePayloadTemplate parses a webhook payload template written in Go template syntax.
+// Missing keys of the incident render as an error instead of "<no value>".
+func ParsePayloadTemplate(text string) (*template.Template, error) {
+	return template.New("payload").Funcs(payloadTemplateFuncs).Option("missingkey=error").Parse(text)
+}
+
+type payloadTemplateValidator struct {
+	message string
+}
+
+func (v payloadTemplateValidator) Description(_ context.Context) string {
+	return v.message
+}
+
+func (v payloadTemplateValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v payloadTemplateValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := ParsePayloadTemplate(request.ConfigValue.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Payload Template",
+			fmt.Sprintf("%s: %s", v.message, err.Error()),
+		)
+	}
+}
+
+// PayloadTemplate returns a validator that ensures the value is a webhook payload template
+// that parses with Go's text/template syntax.
+func PayloadTemplate(message string) payloadTemplateValidator {
+	return payloadTemplateValidator{
+		message: message,
+	}
+}
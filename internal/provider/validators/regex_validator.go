@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type regexValidator struct {
+	message string
+}
+
+func (v regexValidator) Description(_ context.Context) string {
+	return v.message
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	_, err := regexp.Compile(request.ConfigValue.ValueString())
+	if err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("%s: %s", v.message, err.Error()),
+		)
+	}
+}
+
+// Regex returns a validator that ensures the value is a regular expression
+// that compiles with Go's RE2 syntax.
+func Regex(message string) regexValidator {
+	return regexValidator{
+		message: message,
+	}
+}
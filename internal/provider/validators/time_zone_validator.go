@@ -0,0 +1,139 @@
+package validators
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+//go:generate go run gen_time_zones.go
+
+// timeZoneNames lists the zones contained in Go's lib/time/zoneinfo.zip, which is
+// the archive embedded by time/tzdata. It is only used to suggest similar names.
+//
+//go:embed time_zones.txt
+var timeZoneNamesData string
+
+var timeZoneNames = strings.Fields(timeZoneNamesData)
+
+type timeZoneValidator struct {
+	message string
+}
+
+func (v timeZoneValidator) Description(_ context.Context) string {
+	return v.message
+}
+
+func (v timeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v timeZoneValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := request.ConfigValue.ValueString()
+	if IsValidTimeZone(value) {
+		return
+	}
+
+	detail := fmt.Sprintf("%s: %q is not an IANA time zone id", v.message, value)
+	if suggestions := SuggestTimeZones(value); len(suggestions) > 0 {
+		detail = fmt.Sprintf("%s. Did you mean %s?", detail, strings.Join(quoteAll(suggestions), " or "))
+	}
+
+	response.Diagnostics.AddAttributeError(
+		request.Path,
+		"Invalid Time Zone",
+		detail,
+	)
+}
+
+// IsValidTimeZone reports whether value is a time zone id known to Go's embedded tzdata.
+func IsValidTimeZone(value string) bool {
+	if value == "" || value == "Local" {
+		return false
+	}
+
+	_, err := time.LoadLocation(value)
+	return err == nil
+}
+
+// SuggestTimeZones returns up to three known time zone ids that are similar to value.
+func SuggestTimeZones(value string) []string {
+	type candidate struct {
+		name     string
+		distance int
+	}
+
+	lowerValue := strings.ToLower(value)
+	maxDistance := max(1, len(value)/4)
+
+	var candidates []candidate
+	for _, name := range timeZoneNames {
+		distance := levenshtein(lowerValue, strings.ToLower(name))
+		if distance <= maxDistance {
+			candidates = append(candidates, candidate{name: name, distance: distance})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].distance < candidates[j].distance
+	})
+
+	suggestions := make([]string, 0, 3)
+	for _, c := range candidates {
+		if len(suggestions) == 3 {
+			break
+		}
+		suggestions = append(suggestions, c.name)
+	}
+	return suggestions
+}
+
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(rb)]
+}
+
+func quoteAll(values []string) []string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return quoted
+}
+
+// TimeZone returns a validator that ensures the value is an IANA time zone id,
+// suggesting similar ids for typos like "Europe/Zurch".
+func TimeZone(message string) timeZoneValidator {
+	return timeZoneValidator{
+		message: message,
+	}
+}
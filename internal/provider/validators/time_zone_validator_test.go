@@ -0,0 +1,112 @@
+package validators
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTimeZone(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   types.String
+		wantErr string
+	}{
+		{name: "zone", value: types.StringValue("Europe/Zurich")},
+		{name: "utc", value: types.StringValue("UTC")},
+		{name: "null", value: types.StringNull()},
+		{name: "unknown", value: types.StringUnknown()},
+		{name: "empty", value: types.StringValue(""), wantErr: `"" is not an IANA time zone id`},
+		{name: "local", value: types.StringValue("Local"), wantErr: `"Local" is not an IANA time zone id`},
+		{name: "typo", value: types.StringValue("Europe/Zurch"), wantErr: `Did you mean "Europe/Zurich"?`},
+		{name: "case", value: types.StringValue("america/new_york"), wantErr: `Did you mean "America/New_York"?`},
+		{name: "no suggestion", value: types.StringValue("Mars/Olympus_Mons"), wantErr: `"Mars/Olympus_Mons" is not an IANA time zone id`},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			response := validator.StringResponse{}
+			TimeZone("Not a valid time zone id").ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("time_zone_id"),
+				ConfigValue: test.value,
+			}, &response)
+
+			if test.wantErr == "" {
+				if response.Diagnostics.HasError() {
+					t.Fatalf("unexpected error: %v", response.Diagnostics)
+				}
+				return
+			}
+
+			if !response.Diagnostics.HasError() {
+				t.Fatalf("expected error containing %q", test.wantErr)
+			}
+			if detail := response.Diagnostics.Errors()[0].Detail(); !strings.Contains(detail, test.wantErr) {
+				t.Errorf("error = %q, want it to contain %q", detail, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestSuggestTimeZones(t *testing.T) {
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{value: "Europe/Zurch", want: []string{"Europe/Zurich"}},
+		{value: "Europe/Berln", want: []string{"Europe/Berlin"}},
+		{value: "Asia/Calcuta", want: []string{"Asia/Calcutta"}},
+		{value: "US/Pacfic", want: []string{"US/Pacific"}},
+		{value: "Mars/Olympus_Mons", want: []string{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.value, func(t *testing.T) {
+			if got := SuggestTimeZones(test.value); !slices.Equal(got, test.want) {
+				t.Errorf("SuggestTimeZones(%q) = %v, want %v", test.value, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSuggestTimeZonesLimit(t *testing.T) {
+	if got := SuggestTimeZones("Etc/GMT+1"); len(got) != 3 || got[0] != "Etc/GMT+1" {
+		t.Errorf("SuggestTimeZones(%q) = %v, want 3 suggestions starting with the exact match", "Etc/GMT+1", got)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{a: "", b: "", want: 0},
+		{a: "zurich", b: "", want: 6},
+		{a: "zurich", b: "zurich", want: 0},
+		{a: "zurch", b: "zurich", want: 1},
+		{a: "zuirch", b: "zurich", want: 2},
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "são_paulo", b: "sao_paulo", want: 1},
+	}
+
+	for _, test := range tests {
+		if got := levenshtein(test.a, test.b); got != test.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+// The suggestions are only useful if every listed zone is also accepted, see gen_time_zones.go.
+func TestTimeZoneNamesLoad(t *testing.T) {
+	for _, name := range timeZoneNames {
+		if _, err := time.LoadLocation(name); err != nil {
+			t.Errorf("time zone %q from time_zones.txt cannot be loaded: %v", name, err)
+		}
+	}
+}
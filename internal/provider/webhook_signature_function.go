@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &WebhookSignatureFunction{}
+
+func NewWebhookSignatureFunction() function.Function {
+	return &WebhookSignatureFunction{}
+}
+
+// WebhookSignatureFunction computes the signature All Quiet expects from senders of hmac authenticated webhooks.
+type WebhookSignatureFunction struct{}
+
+var webhookHmacHashes = map[string]func() hash.Hash{
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+	"sha512": sha512.New,
+}
+
+func (f *WebhookSignatureFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "webhook_signature"
+}
+
+func (f *WebhookSignatureFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Computes the signature of an hmac authenticated webhook",
+		MarkdownDescription: "Computes the signature a sender has to send in the `signature_header` of an integration with `hmac` webhook authentication, " +
+			"as `<algorithm>=<hex encoded hmac>`. If a timestamp is given, `<timestamp>.<payload>` is signed like when `timestamp_header` is set.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "secret",
+				MarkdownDescription: "The secret of the webhook authentication",
+			},
+			function.StringParameter{
+				Name:                "payload",
+				MarkdownDescription: "The body of the webhook",
+			},
+			function.StringParameter{
+				Name:                "algorithm",
+				MarkdownDescription: "The hash algorithm. Possible values are: " + strings.Join(ValidWebhookHmacAlgorithms, ", ") + ". If null, sha256 is used.",
+				AllowNullValue:      true,
+			},
+			function.StringParameter{
+				Name:                "timestamp",
+				MarkdownDescription: "The unix timestamp sent in the `timestamp_header`, or null if the integration has no timestamp header",
+				AllowNullValue:      true,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *WebhookSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var secret string
+	var payload string
+	var algorithm *string
+	var timestamp *string
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &secret, &payload, &algorithm, &timestamp))
+	if resp.Error != nil {
+		return
+	}
+
+	algorithmName := "sha256"
+	if algorithm != nil {
+		algorithmName = *algorithm
+	}
+
+	if _, ok := webhookHmacHashes[algorithmName]; !ok {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("Invalid algorithm %q, possible values are: %s", algorithmName, strings.Join(ValidWebhookHmacAlgorithms, ", ")))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, computeWebhookSignature(secret, payload, algorithmName, timestamp)))
+}
+
+func computeWebhookSignature(secret string, payload string, algorithm string, timestamp *string) string {
+	mac := hmac.New(webhookHmacHashes[algorithm], []byte(secret))
+	if timestamp != nil {
+		mac.Write([]byte(*timestamp + "."))
+	}
+	mac.Write([]byte(payload))
+
+	return algorithm + "=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+)
+
+func TestAccWebhookSignatureFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::webhook_signature("whsec_0123456789abcdef", "{\"title\":\"Disk full\"}", "sha512", null)
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("sha512=9805d8acd48386ff83911542cbcabb88889acad692e990a6948a96f1fa9d6357bda07fb41c4c4d202a6c469d271daf65ac00b31dfcea1316da49cd2c8e212f6c")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::allquiet::webhook_signature("whsec_0123456789abcdef", "{}", "md5", null)
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid algorithm "md5"`),
+			},
+		},
+	})
+}
+
+func TestAccWebhookSignatureFunctionExample(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookSignatureFunctionExample(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("github_signature", knownvalue.StringExact("sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17")),
+					statecheck.ExpectKnownOutputValue("timestamped_signature", knownvalue.StringExact("sha256=656968ced300da96eea47cd316b793479eb12ed7333eb461401f0b64c05eaf71")),
+				},
+			},
+		},
+	})
+}
+
+func testAccWebhookSignatureFunctionExample() string {
+	absPath, _ := filepath.Abs("../../examples/functions/webhook_signature/function.tf")
+
+	dat, err := os.ReadFile(absPath)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(dat)
+}